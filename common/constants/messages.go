@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	MON       = "월요일"
 	TUE       = "화요일"
@@ -16,9 +18,43 @@ const (
 	LectureCreditMax   = 6
 	LectureCapacityMin = 1
 	LectureCapacityMax = 30
+	LectureSlotsMin    = 1
+	LectureSlotsMax    = 5
+
+	LectureTimeUnitDefault = 5
+	CampusOpenTimeDefault  = "08:00"
+	CampusCloseTimeDefault = "22:00"
+
+	// DefaultTermID 학기 도입 전 데이터가 옮겨진 학기 (마이그레이션 0006과 같은 값)
+	DefaultTermID        = "2026-2"
+	DefaultTermStartDate = "2026-09-01"
+	DefaultTermEndDate   = "2027-02-28"
+
+	RoomNameMin  = 1
+	RoomNameMax  = 30
+	RoomSeatsMin = 1
+	RoomSeatsMax = 500
 
 	StudentIdMin = 1000
 	StudentIdMax = 9999
 
 	TotalCreditLimit = 18
+
+	LecturePageSizeDefault = 20
+	LecturePageSizeMax     = 100
+	LectureSearchLimit     = 10
+
+	EnrollmentConflictMaxAttempts = 3
+	LockTimeoutDefault            = 5 * time.Second
+	LectureCacheTTLDefault        = 3 * time.Second
+
+	ReadTimeoutDefault   = 3 * time.Second
+	WriteTimeoutDefault  = 5 * time.Second
+	EnrollTimeoutDefault = 10 * time.Second
+
+	StoreRetryAttemptsDefault  = 3
+	StoreRetryBaseDelayDefault = 50 * time.Millisecond
+	StoreRetryMaxDelayDefault  = time.Second
+	BreakerThresholdDefault    = 5
+	BreakerCooldownDefault     = 10 * time.Second
 )
@@ -0,0 +1,112 @@
+package exception
+
+import (
+	"golang-course-registration/common/i18n"
+	"strconv"
+)
+
+// Kind 에러 분류, 컨트롤러가 HTTP 상태 코드를 정할 때 사용
+type Kind int
+
+const (
+	KindInternal    Kind = iota // 예상하지 못한 오류 (500)
+	KindBadRequest              // 요청 형식 오류 (400)
+	KindInvalid                 // 입력 값 검증 실패, 업무 규칙 위반 (422)
+	KindNotFound                // 대상 없음 (404)
+	KindConflict                // 중복, 정원 초과 등 현재 상태와 충돌 (409)
+	KindUnavailable             // 일시적으로 처리 불가 (503)
+	KindTimeout                 // 처리 제한 시간 초과 (504)
+)
+
+// Error 기계가 읽을 수 있는 코드와 사용자에게 보여줄 메시지를 가진 도메인 에러
+// errors.Is는 코드가 같으면 일치하므로, 메시지에 값을 덧붙인 에러도 기준 에러와 비교할 수 있음
+type Error struct {
+	Code    string
+	Kind    Kind
+	Message string
+	Params  map[string]string // 다른 언어 메시지의 {이름} 자리에 넣을 값
+	Details FieldErrors       // 요청 검증 실패 시 필드별 상세
+	// Conflicts 강좌 시간 변경으로 다른 강좌와 시간이 겹치게 되는 수강생
+	Conflicts []StudentConflict
+}
+
+// StudentConflict 강좌를 변경하면 시간이 겹치게 되는 수강생과 겹치는 강좌
+type StudentConflict struct {
+	StudentID   int
+	LectureID   int
+	LectureName string
+}
+
+// registered 미리 정의한 에러 목록 (언어별 메시지 누락 검사용)
+var registered []*Error
+
+func (e *Error) Error() string {
+	if len(e.Details) > 0 {
+		return e.Message + " (" + e.Details.String() + ")"
+	}
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// Unwrap 필드별 상세 에러, errors.Is(err, ErrLectureCreditInvalid)처럼 개별 검증 실패를 확인할 때 사용
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, len(e.Details))
+	for _, fieldErr := range e.Details {
+		errs = append(errs, fieldErr.Err)
+	}
+	return errs
+}
+
+// Localize 해당 언어의 메시지, 카탈로그에 없거나 Default 언어이면 정의된 한국어 메시지
+func (e *Error) Localize(locale i18n.Locale) string {
+	if message, ok := i18n.Lookup(locale, e.Code); ok {
+		return i18n.Interpolate(message, e.Params)
+	}
+	return e.Message
+}
+
+func newError(kind Kind, code, message string) *Error {
+	err := &Error{Code: code, Kind: kind, Message: message}
+	registered = append(registered, err)
+	return err
+}
+
+// TimeConflict 시간이 겹치는 강좌명을 포함한 시간 충돌 에러 (errors.Is(err, ErrTimeConflict) 성립)
+func TimeConflict(lectureName string) error {
+	return &Error{
+		Code:    ErrTimeConflict.Code,
+		Kind:    ErrTimeConflict.Kind,
+		Message: lectureName + " " + ErrTimeConflict.Message,
+		Params:  map[string]string{"lecture": lectureName},
+	}
+}
+
+// RoomDoubleBooked 같은 강의실에서 시간이 겹치는 강좌명을 포함한 에러 (errors.Is(err, ErrRoomDoubleBooked) 성립)
+func RoomDoubleBooked(lectureName string) error {
+	return &Error{
+		Code:    ErrRoomDoubleBooked.Code,
+		Kind:    ErrRoomDoubleBooked.Kind,
+		Message: lectureName + " " + ErrRoomDoubleBooked.Message,
+		Params:  map[string]string{"lecture": lectureName},
+	}
+}
+
+// StudentTimeConflicts 변경하려는 강좌 시간이 수강생의 다른 강좌와 겹치는 에러 (errors.Is(err, ErrLectureUpdateTimeConflict) 성립)
+func StudentTimeConflicts(conflicts []StudentConflict) error {
+	students := make(map[int]struct{}, len(conflicts))
+	for _, conflict := range conflicts {
+		students[conflict.StudentID] = struct{}{}
+	}
+	count := strconv.Itoa(len(students))
+	return &Error{
+		Code:      ErrLectureUpdateTimeConflict.Code,
+		Kind:      ErrLectureUpdateTimeConflict.Kind,
+		Message:   "수강생 " + count + "명의 " + ErrLectureUpdateTimeConflict.Message,
+		Params:    map[string]string{"count": count},
+		Conflicts: conflicts,
+	}
+}
@@ -0,0 +1,42 @@
+package exception
+
+import (
+	"errors"
+	"golang-course-registration/common/i18n"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	t.Run("모든 에러 코드의 영어 메시지 존재", func(t *testing.T) {
+		for _, err := range registered {
+			if _, ok := i18n.Lookup(i18n.English, err.Code); !ok {
+				t.Errorf("기대 : %s 영어 메시지, 결과 : 없음", err.Code)
+			}
+		}
+	})
+
+	t.Run("한국어는 정의된 메시지 그대로", func(t *testing.T) {
+		// when
+		message := ErrLectureNotFound.Localize(i18n.Korean)
+
+		// then
+		if message != ErrLectureNotFound.Message {
+			t.Errorf("기대 : %s, 결과 : %s", ErrLectureNotFound.Message, message)
+		}
+	})
+
+	t.Run("값을 포함한 에러의 영어 메시지", func(t *testing.T) {
+		// given
+		var err *Error
+		errors.As(TimeConflict("데이터베이스"), &err)
+
+		// when
+		message := err.Localize(i18n.English)
+
+		// then
+		expected := "The schedule overlaps with 데이터베이스."
+		if message != expected {
+			t.Errorf("기대 : %s, 결과 : %s", expected, message)
+		}
+	})
+}
@@ -1,39 +1,100 @@
 package exception
 
-// Student 관련 예외 메시지
-const (
-	ErrStudentNotFound  = "존재하지 않는 학생입니다"
-	ErrStudentIDInvalid = "학번(ID)은 1000 ~ 9999 사이의 숫자여야 합니다"
+// Student 관련 예외
+var (
+	ErrStudentNotFound    = newError(KindNotFound, "STUDENT_NOT_FOUND", "존재하지 않는 학생입니다")
+	ErrStudentIDInvalid   = newError(KindInvalid, "STUDENT_ID_INVALID", "학번(ID)은 1000 ~ 9999 사이의 숫자여야 합니다")
+	ErrStudentIDDuplicate = newError(KindConflict, "STUDENT_ID_DUPLICATE", "이미 등록된 학번입니다")
 )
 
-// Lecture 관련 예외 메시지
-const (
-	ErrLectureNameRequired     = "강좌명은 2~20자 사이여야 합니다"
-	ErrLectureIDInvalid        = "강좌번호는 1000 ~ 9999 사이의 숫자여야 합니다"
-	ErrLectureCapacityInvalid  = "정원은 1명 이상, 30명 이하여야 합니다"
-	ErrLectureDayRequired      = "강좌 요일은 필수입니다"
-	ErrLectureTimeRequired     = "시작/종료 시간은 필수입니다"
-	ErrLectureTimeOrderInvalid = "종료 시간은 시작 시간 이후여야 합니다"
-	ErrLectureNameDuplicate    = "이미 존재하는 강좌명입니다"
-	ErrLectureIDDuplicate      = "이미 존재하는 강좌번호입니다"
-	ErrLectureCreditInvalid    = "학점은 1학점 이상, 6학점 이하여야 합니다"
-	ErrLectureListIsEmpty      = "강좌 생성 결과가 비어 있습니다"
-)
-
-// Enrollment 관련 예외 메시지
-const (
-	ErrEnrollmentLectureIDRequired = "강좌번호는 필수입니다"
-	ErrLectureNotFound             = "존재하지 않는 강좌입니다"
-	ErrTimeConflict                = "강좌와 시간이 중복됩니다"
-	ErrLectureCapacityExceeded     = "강좌 정원이 초과되었습니다"
-	ErrCreditLimitExceeded         = "총 학점이 18학점을 초과할 수 없습니다"
+// Lecture 관련 예외
+var (
+	ErrLectureNameRequired            = newError(KindInvalid, "LECTURE_NAME_REQUIRED", "강좌명은 2~20자 사이여야 합니다")
+	ErrLectureIDInvalid               = newError(KindInvalid, "LECTURE_ID_INVALID", "강좌번호는 1000 ~ 9999 사이의 숫자여야 합니다")
+	ErrLectureCapacityInvalid         = newError(KindInvalid, "LECTURE_CAPACITY_INVALID", "정원은 1명 이상, 30명 이하여야 합니다")
+	ErrLectureDayRequired             = newError(KindInvalid, "LECTURE_DAY_REQUIRED", "강좌 요일은 필수입니다")
+	ErrLectureTimeRequired            = newError(KindInvalid, "LECTURE_TIME_REQUIRED", "시작/종료 시간은 필수입니다")
+	ErrLectureTimeOrderInvalid        = newError(KindInvalid, "LECTURE_TIME_ORDER_INVALID", "종료 시간은 시작 시간 이후여야 합니다")
+	ErrLectureNameDuplicate           = newError(KindConflict, "LECTURE_NAME_DUPLICATE", "같은 학기에 이미 존재하는 강좌명입니다")
+	ErrLectureIDDuplicate             = newError(KindConflict, "LECTURE_ID_DUPLICATE", "이미 존재하는 강좌번호입니다")
+	ErrLectureCreditInvalid           = newError(KindInvalid, "LECTURE_CREDIT_INVALID", "학점은 1학점 이상, 6학점 이하여야 합니다")
+	ErrLectureListIsEmpty             = newError(KindInternal, "LECTURE_LIST_IS_EMPTY", "강좌 생성 결과가 비어 있습니다")
+	ErrLectureDayInvalid              = newError(KindInvalid, "LECTURE_DAY_INVALID", "요일은 MON, TUE, WED, THU, FRI 중 하나여야 합니다")
+	ErrLectureTimeFormatInvalid       = newError(KindInvalid, "LECTURE_TIME_FORMAT_INVALID", "시간은 HH:MM 형식이어야 합니다")
+	ErrLectureSortInvalid             = newError(KindInvalid, "LECTURE_SORT_INVALID", "정렬 기준은 id, name, credit, capacity, start_time 중 하나여야 합니다")
+	ErrSortOrderInvalid               = newError(KindInvalid, "SORT_ORDER_INVALID", "정렬 방향은 asc 또는 desc여야 합니다")
+	ErrPageInvalid                    = newError(KindInvalid, "PAGE_INVALID", "페이지는 1 이상이어야 합니다")
+	ErrPageSizeInvalid                = newError(KindInvalid, "PAGE_SIZE_INVALID", "페이지 크기는 1 ~ 100 사이여야 합니다")
+	ErrSearchQueryRequired            = newError(KindInvalid, "SEARCH_QUERY_REQUIRED", "검색어를 입력해주세요")
+	ErrLectureUpdateEmpty             = newError(KindInvalid, "LECTURE_UPDATE_EMPTY", "변경할 항목이 없습니다")
+	ErrLectureCapacityBelowEnrollment = newError(KindInvalid, "LECTURE_CAPACITY_BELOW_ENROLLMENT", "정원은 현재 수강 인원보다 작을 수 없습니다")
+	ErrLectureUpdateTimeConflict      = newError(KindConflict, "LECTURE_UPDATE_TIME_CONFLICT", "다른 강좌와 시간이 겹칩니다")
+	ErrLectureSlotsInvalid            = newError(KindInvalid, "LECTURE_SLOTS_INVALID", "수업 시간은 1개 이상, 5개 이하여야 합니다")
+	ErrLectureSlotsOverlap            = newError(KindInvalid, "LECTURE_SLOTS_OVERLAP", "같은 강좌의 수업 시간끼리 겹칠 수 없습니다")
+	ErrLectureTimeGranularity         = newError(KindInvalid, "LECTURE_TIME_GRANULARITY", "수업 시각이 허용된 분 단위에 맞지 않습니다")
+	ErrLectureTimeOutsideHours        = newError(KindInvalid, "LECTURE_TIME_OUTSIDE_HOURS", "수업 시간은 캠퍼스 운영 시간 안이어야 합니다")
+	ErrSchedulePolicyInvalid          = newError(KindInvalid, "SCHEDULE_POLICY_INVALID", "수업 시간 정책은 분 단위가 1 이상이고 운영 종료 시각이 시작 시각보다 늦어야 합니다")
 )
 
-// Controller 관련 예외 메시지
-const (
-	ErrInvalidRequestBody = "요청 본문이 올바르지 않습니다"
-	ErrLectureListFailed  = "강좌 목록 조회 실패"
-	ErrStudentIDNotNumber = "학번은 숫자여야 합니다"
+// Term 관련 예외
+var (
+	ErrTermIDInvalid         = newError(KindInvalid, "TERM_ID_INVALID", "학기는 2026-1, 2026-2처럼 연도-학기(1 또는 2) 형식이어야 합니다")
+	ErrTermDateInvalid       = newError(KindInvalid, "TERM_DATE_INVALID", "학기 시작/종료일은 YYYY-MM-DD 형식이어야 합니다")
+	ErrTermDateOrderInvalid  = newError(KindInvalid, "TERM_DATE_ORDER_INVALID", "학기 종료일은 시작일 이후여야 합니다")
+	ErrTermDuplicate         = newError(KindConflict, "TERM_DUPLICATE", "이미 등록된 학기입니다")
+	ErrTermNotFound          = newError(KindNotFound, "TERM_NOT_FOUND", "존재하지 않는 학기입니다")
+	ErrTermCloneSameTerm     = newError(KindInvalid, "TERM_CLONE_SAME_TERM", "원본 학기와 대상 학기가 같습니다")
+	ErrTermCloneScaleInvalid = newError(KindInvalid, "TERM_CLONE_CAPACITY_SCALE_INVALID", "정원 배율은 0보다 커야 합니다")
+)
+
+// Room 관련 예외
+var (
+	ErrRoomIDInvalid               = newError(KindInvalid, "ROOM_ID_INVALID", "강의실 번호는 영문 대문자, 숫자, -로 된 2~20자여야 합니다")
+	ErrRoomNameInvalid             = newError(KindInvalid, "ROOM_NAME_INVALID", "강의실 이름은 1~30자여야 합니다")
+	ErrRoomSeatsInvalid            = newError(KindInvalid, "ROOM_SEATS_INVALID", "좌석 수는 1석 이상, 500석 이하여야 합니다")
+	ErrRoomUpdateEmpty             = newError(KindInvalid, "ROOM_UPDATE_EMPTY", "변경할 항목이 없습니다")
+	ErrRoomDuplicate               = newError(KindConflict, "ROOM_DUPLICATE", "이미 등록된 강의실입니다")
+	ErrRoomNotFound                = newError(KindNotFound, "ROOM_NOT_FOUND", "존재하지 않는 강의실입니다")
+	ErrRoomInUse                   = newError(KindConflict, "ROOM_IN_USE", "강좌가 배정된 강의실은 삭제할 수 없습니다")
+	ErrRoomSeatsBelowCapacity      = newError(KindConflict, "ROOM_SEATS_BELOW_CAPACITY", "배정된 강좌의 정원보다 좌석 수를 줄일 수 없습니다")
+	ErrRoomDoubleBooked            = newError(KindConflict, "ROOM_DOUBLE_BOOKED", "강좌와 강의실 사용 시간이 겹칩니다")
+	ErrLectureCapacityExceedsSeats = newError(KindInvalid, "LECTURE_CAPACITY_EXCEEDS_SEATS", "정원은 강의실 좌석 수를 넘을 수 없습니다")
+)
+
+// Enrollment 관련 예외
+var (
+	ErrEnrollmentLectureIDRequired = newError(KindInvalid, "ENROLLMENT_LECTURE_ID_REQUIRED", "강좌번호는 필수입니다")
+	ErrLectureNotFound             = newError(KindNotFound, "LECTURE_NOT_FOUND", "존재하지 않는 강좌입니다")
+	ErrTimeConflict                = newError(KindConflict, "TIME_CONFLICT", "강좌와 시간이 중복됩니다")
+	ErrLectureCapacityExceeded     = newError(KindConflict, "LECTURE_CAPACITY_EXCEEDED", "강좌 정원이 초과되었습니다")
+	ErrCreditLimitExceeded         = newError(KindInvalid, "CREDIT_LIMIT_EXCEEDED", "총 학점이 18학점을 초과할 수 없습니다")
+	ErrLectureVersionConflict      = newError(KindConflict, "LECTURE_VERSION_CONFLICT", "다른 요청이 강좌 정보를 먼저 변경했습니다")
+	ErrLockTimeout                 = newError(KindUnavailable, "LOCK_TIMEOUT", "신청이 몰려 처리하지 못했습니다. 잠시 후 다시 시도해주세요")
+	ErrEnrollmentNotFound          = newError(KindNotFound, "ENROLLMENT_NOT_FOUND", "수강신청 내역이 존재하지 않습니다")
+	ErrEnrollmentStatusInvalid     = newError(KindInvalid, "ENROLLMENT_STATUS_INVALID", "수강신청 상태는 ENROLLED, DROPPED, WITHDRAWN, WAITLISTED 중 하나여야 합니다")
+	ErrEnrollmentStatusTransition  = newError(KindConflict, "ENROLLMENT_STATUS_TRANSITION_INVALID", "현재 상태에서는 변경할 수 없는 수강신청입니다")
+	ErrLectureTermNotActive        = newError(KindConflict, "LECTURE_TERM_NOT_ACTIVE", "현재 학기의 강좌만 신청할 수 있습니다")
+)
+
+// Waitlist 관련 예외
+var (
+	ErrWaitlistLectureNotFull  = newError(KindConflict, "WAITLIST_LECTURE_NOT_FULL", "정원이 남아 있는 강좌입니다. 바로 수강신청해주세요")
+	ErrWaitlistAlreadyEnrolled = newError(KindConflict, "WAITLIST_ALREADY_ENROLLED", "이미 수강신청한 강좌입니다")
+	ErrWaitlistDuplicate       = newError(KindConflict, "WAITLIST_DUPLICATE", "이미 대기 중인 강좌입니다")
+	ErrWaitlistNotFound        = newError(KindNotFound, "WAITLIST_NOT_FOUND", "대기 내역이 존재하지 않습니다")
+)
+
+// Controller 관련 예외
+var (
+	ErrInvalidRequestBody = newError(KindBadRequest, "INVALID_REQUEST_BODY", "요청 본문이 올바르지 않습니다")
+	ErrValidationFailed   = newError(KindInvalid, "VALIDATION_FAILED", "입력 값이 올바르지 않습니다")
+	ErrFieldInvalid       = newError(KindInvalid, "FIELD_INVALID", "값의 형식이 올바르지 않습니다")
+	ErrStudentIDNotNumber = newError(KindBadRequest, "STUDENT_ID_NOT_NUMBER", "학번은 숫자여야 합니다")
+	ErrRepairFlagInvalid  = newError(KindBadRequest, "REPAIR_FLAG_INVALID", "repair 값은 true 또는 false여야 합니다")
+	ErrInvalidQueryParam  = newError(KindBadRequest, "INVALID_QUERY_PARAM", "쿼리 파라미터가 올바르지 않습니다")
+	ErrRequestTimeout     = newError(KindTimeout, "REQUEST_TIMEOUT", "요청 처리 시간이 초과되었습니다. 잠시 후 다시 시도해주세요")
+	ErrInternal           = newError(KindInternal, "INTERNAL_ERROR", "요청을 처리하지 못했습니다. 잠시 후 다시 시도해주세요")
+	ErrServiceUnavailable = newError(KindUnavailable, "SERVICE_UNAVAILABLE", "서비스를 일시적으로 사용할 수 없습니다. 잠시 후 다시 시도해주세요")
 )
 
 // 서버 관련 예외 메시지
@@ -42,9 +103,15 @@ const (
 	ErrFailedCreateClient    = "db 클라이언트 생성 실패"
 	ErrNotFoundDirectory     = "작업 디렉토리를 가져올 수 없습니다"
 	ErrEnvFileLoad           = "env 파일을 불러오지 못했습니다"
+	ErrStorageBackendInvalid = "지원하지 않는 저장소 백엔드입니다"
+	ErrLockBackendInvalid    = "지원하지 않는 잠금 백엔드입니다"
 )
 
-// TimeConflictMessage 시간 충돌 메시지 생성
-func TimeConflictMessage(lectureName string) string {
-	return lectureName + " " + ErrTimeConflict
-}
+// 마이그레이션 관련 예외 메시지
+const (
+	ErrMigrationDialectInvalid = "지원하지 않는 마이그레이션 대상 DB입니다"
+	ErrMigrationFileInvalid    = "마이그레이션 파일 이름이 올바르지 않습니다"
+	ErrMigrationFailed         = "마이그레이션 적용 실패"
+	ErrMigrationCommandInvalid = "사용법: main.go migrate up|down|status"
+	ErrMigrationNotSupported   = "memory 저장소는 마이그레이션을 지원하지 않습니다"
+)
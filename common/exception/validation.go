@@ -0,0 +1,59 @@
+package exception
+
+import (
+	"errors"
+	"strings"
+)
+
+// FieldError 요청 필드 하나의 검증 실패 (Field는 json 필드 이름)
+type FieldError struct {
+	Field string
+	Err   *Error
+}
+
+// FieldErrors 모든 필드를 검사한 뒤 실패한 필드를 한 번에 반환하기 위한 수집기
+type FieldErrors []FieldError
+
+// Add err가 있으면 field의 검증 실패로 기록 (도메인 에러가 아니면 입력 값 검증 실패로 기록)
+func (f *FieldErrors) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+	var domainErr *Error
+	if !errors.As(err, &domainErr) {
+		domainErr = ErrFieldInvalid
+	}
+	*f = append(*f, FieldError{Field: field, Err: domainErr})
+}
+
+// Has field에 이미 기록된 검증 실패가 있는지 (앞선 검사가 실패하면 이어지는 검사를 건너뛸 때 사용)
+func (f FieldErrors) Has(field string) bool {
+	for _, fieldErr := range f {
+		if fieldErr.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Err 실패한 필드가 없으면 nil, 있으면 필드별 상세를 담은 ErrValidationFailed
+// errors.Is는 개별 필드의 에러(ErrLectureCreditInvalid 등)와도 일치
+func (f FieldErrors) Err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return &Error{
+		Code:    ErrValidationFailed.Code,
+		Kind:    ErrValidationFailed.Kind,
+		Message: ErrValidationFailed.Message,
+		Details: f,
+	}
+}
+
+func (f FieldErrors) String() string {
+	messages := make([]string, 0, len(f))
+	for _, fieldErr := range f {
+		messages = append(messages, fieldErr.Field+": "+fieldErr.Err.Message)
+	}
+	return strings.Join(messages, ", ")
+}
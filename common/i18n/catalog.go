@@ -0,0 +1,52 @@
+package i18n
+
+import "strings"
+
+// catalogs 언어별 메시지, 키는 화면 문구("admin.title") 또는 에러 코드("LECTURE_NOT_FOUND")
+// 한국어 에러 메시지는 exception 패키지의 정의를 그대로 사용하므로 카탈로그에는 화면 문구만 둠
+var catalogs = map[Locale]map[string]string{
+	Korean:  koreanMessages,
+	English: englishMessages,
+}
+
+// Lookup 해당 언어에 정의된 메시지만 조회 (Default 언어로 대체하지 않음)
+func Lookup(locale Locale, key string) (string, bool) {
+	message, ok := catalogs[locale][key]
+	return message, ok
+}
+
+// T 해당 언어의 메시지, 없으면 Default 언어의 메시지, 그것도 없으면 키를 그대로 반환
+func T(locale Locale, key string) string {
+	if message, ok := Lookup(locale, key); ok {
+		return message
+	}
+	if message, ok := Lookup(Default, key); ok {
+		return message
+	}
+	return key
+}
+
+// Format T로 찾은 메시지의 {이름} 자리를 params 값으로 치환
+func Format(locale Locale, key string, params map[string]string) string {
+	return Interpolate(T(locale, key), params)
+}
+
+// Interpolate message의 {이름} 자리를 params 값으로 치환
+func Interpolate(message string, params map[string]string) string {
+	for name, value := range params {
+		message = strings.ReplaceAll(message, "{"+name+"}", value)
+	}
+	return message
+}
+
+// Messages 화면 스크립트에 전달할 전체 메시지 (해당 언어에 없는 키는 Default 언어로 채움)
+func Messages(locale Locale) map[string]string {
+	messages := make(map[string]string, len(catalogs[Default]))
+	for key, message := range catalogs[Default] {
+		messages[key] = message
+	}
+	for key, message := range catalogs[locale] {
+		messages[key] = message
+	}
+	return messages
+}
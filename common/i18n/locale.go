@@ -0,0 +1,72 @@
+package i18n
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Locale 응답 메시지와 화면 문구의 언어
+type Locale string
+
+const (
+	Korean  Locale = "ko"
+	English Locale = "en"
+
+	Default = Korean
+)
+
+// Supported 메시지 카탈로그가 있는 언어 (우선순위가 같으면 앞선 언어 선택)
+var Supported = []Locale{Korean, English}
+
+// Parse "en", "en-US", "EN_us" 같은 언어 태그를 지원 언어로 변환
+func Parse(tag string) (Locale, bool) {
+	base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	base, _, _ = strings.Cut(base, "_")
+	for _, locale := range Supported {
+		if string(locale) == base {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// Negotiate Accept-Language 헤더에서 품질 값(q)이 가장 높은 지원 언어를 선택, 없으면 Default
+func Negotiate(acceptLanguage string) Locale {
+	best, bestQuality := Default, 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale, ok := Parse(tag)
+		if strings.TrimSpace(tag) == "*" {
+			locale, ok = Default, true
+		}
+		if ok && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+type localeKey struct{}
+
+// WithLocale 요청 컨텍스트에 협상된 언어를 저장
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext 요청 컨텍스트의 언어, 협상 전이거나 스케줄러 등 요청 밖에서는 Default
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(localeKey{}).(Locale); ok {
+		return locale
+	}
+	return Default
+}
@@ -0,0 +1,67 @@
+package i18n
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		expected       Locale
+	}{
+		{"헤더 없음", "", Default},
+		{"지역 태그", "en-US", English},
+		{"품질 값이 높은 언어 우선", "ko;q=0.5, en-GB;q=0.8", English},
+		{"지원하지 않는 언어는 건너뜀", "fr-FR, en;q=0.3", English},
+		{"지원하지 않는 언어만 있으면 기본 언어", "fr, de;q=0.9", Default},
+		{"품질 값 0은 제외", "en;q=0, ja", Default},
+		{"와일드카드", "*", Default},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			locale := Negotiate(tc.acceptLanguage)
+
+			// then
+			if locale != tc.expected {
+				t.Errorf("기대 : %s, 결과 : %s", tc.expected, locale)
+			}
+		})
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	t.Run("모든 화면 문구의 영어 메시지 존재", func(t *testing.T) {
+		for key := range koreanMessages {
+			if _, ok := Lookup(English, key); !ok {
+				t.Errorf("기대 : %s 영어 메시지, 결과 : 없음", key)
+			}
+		}
+	})
+
+	t.Run("없는 키는 기본 언어, 그것도 없으면 키 그대로", func(t *testing.T) {
+		// given
+		catalogs[English] = map[string]string{}
+		t.Cleanup(func() { catalogs[English] = englishMessages })
+
+		// when
+		fallback := T(English, "admin.title")
+		missing := T(English, "unknown.key")
+
+		// then
+		if fallback != koreanMessages["admin.title"] || missing != "unknown.key" {
+			t.Errorf("기대 : %s, unknown.key, 결과 : %s, %s", koreanMessages["admin.title"], fallback, missing)
+		}
+	})
+
+	t.Run("메시지의 자리 표시자 치환", func(t *testing.T) {
+		// when
+		message := Format(English, "client.pager.info", map[string]string{"page": "2", "total": "5", "count": "93"})
+
+		// then
+		expected := "Page 2 of 5 (93 total)"
+		if message != expected {
+			t.Errorf("기대 : %s, 결과 : %s", expected, message)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package i18n
+
+var englishMessages = map[string]string{
+	// 요일
+	"day.MON": "Monday",
+	"day.TUE": "Tuesday",
+	"day.WED": "Wednesday",
+	"day.THU": "Thursday",
+	"day.FRI": "Friday",
+
+	// 공통
+	"site.title":           "Course Registration",
+	"site.heading":         "Course Registration System",
+	"common.select":        "Select",
+	"common.refresh":       "Refresh",
+	"common.loading":       "Loading...",
+	"common.delete":        "Delete",
+	"common.requestFailed": "The request could not be processed.",
+	"unit.credits":         "{count} cr.",
+	"unit.people":          "{count}",
+
+	// 강좌 항목
+	"lecture.id":                "Lecture No.",
+	"lecture.idColumn":          "Lecture ID",
+	"lecture.name":              "Name",
+	"lecture.capacity":          "Capacity",
+	"lecture.credit":            "Credits",
+	"lecture.day":               "Day",
+	"lecture.startTime":         "Start time",
+	"lecture.endTime":           "End time",
+	"lecture.slots":             "Meeting times",
+	"lecture.currentEnrollment": "Enrolled",
+	"lecture.empty":             "No lectures have been registered.",
+
+	// 학생 항목
+	"student.idRequired": "Please enter your student ID.",
+	"student.idInvalid":  "Student ID must be a number between 1000 and 9999.",
+
+	// 메인 페이지
+	"index.title":               "Home",
+	"index.welcome":             "Welcome to the course registration system",
+	"index.admin.heading":       "Admin portal",
+	"index.admin.description":   "Register lectures from the dashboard.",
+	"index.admin.link":          "Go to admin dashboard",
+	"index.student.heading":     "Student portal",
+	"index.student.description": "Enter your student ID.",
+	"index.student.placeholder": "Student ID",
+	"index.student.submit":      "Go",
+
+	// 관리자 대시보드
+	"admin.title":                  "Admin dashboard",
+	"admin.intro":                  "Register and manage lectures.",
+	"admin.create.heading":         "Register lecture",
+	"admin.create.namePlaceholder": "Advanced Algorithms",
+	"admin.create.submit":          "Register lecture",
+	"admin.create.addSlot":         "Add meeting time",
+	"admin.create.removeSlot":      "Remove",
+	"admin.create.pending":         "Registering...",
+	"admin.create.failed":          "Failed to register the lecture.",
+	"admin.create.success":         "The lecture has been registered.",
+	"admin.list.heading":           "Registered lectures",
+	"admin.list.failed":            "Failed to load lectures.",
+	"admin.list.number":            "No.",
+	"admin.delete.confirm":         "Are you sure you want to delete \"{name}\"?",
+	"admin.delete.pending":         "Deleting...",
+	"admin.delete.failed":          "Failed to delete the lecture.",
+	"admin.delete.success":         "The lecture has been deleted.",
+	"admin.room.delete.success":    "The room has been deleted.",
+
+	// 수강생 대시보드
+	"client.title":                  "Student dashboard",
+	"client.currentStudent":         "Student ID:",
+	"client.studentUnset":           "Not set",
+	"client.lectures.heading":       "Lectures",
+	"client.lectures.hint":          "Click \"Enroll\" next to a lecture to register for it.",
+	"client.filter.allDays":         "All days",
+	"client.filter.namePlaceholder": "Search by name",
+	"client.filter.openOnly":        "Only lectures with open seats",
+	"client.filter.apply":           "Search",
+	"client.pager.prev":             "Previous",
+	"client.pager.next":             "Next",
+	"client.pager.info":             "Page {page} of {total} ({count} total)",
+	"client.enrollments.heading":    "My enrollments",
+	"client.enrollments.empty":      "You have not enrolled in any lectures.",
+	"client.enroll.button":          "Enroll",
+	"client.enroll.studentRequired": "Please set your student ID first.",
+	"client.enroll.pending":         "Enrolling...",
+	"client.enroll.success":         "You have enrolled in \"{name}\".",
+	"client.cancel.studentRequired": "Please set your student ID first.",
+	"client.cancel.confirm":         "Cancel your enrollment in \"{name}\"?",
+	"client.cancel.pending":         "Cancelling...",
+	"client.cancel.success":         "Your enrollment has been cancelled.",
+	"client.waitlist.confirm":       "\"{name}\" is full. Join the waitlist? You will be enrolled automatically in order when a seat opens.",
+	"client.waitlist.joined":        "You joined the waitlist for \"{name}\". (Position {position})",
+	"client.waitlist.leave.success": "You have left the waitlist.",
+
+	// 오류 페이지
+	"error.title":   "Error",
+	"error.heading": "Something went wrong",
+	"error.home":    "Back to home",
+
+	// Student 관련 예외
+	"STUDENT_NOT_FOUND":    "Student does not exist.",
+	"STUDENT_ID_INVALID":   "Student ID must be a number between 1000 and 9999.",
+	"STUDENT_ID_DUPLICATE": "This student ID is already registered.",
+
+	// Lecture 관련 예외
+	"LECTURE_NAME_REQUIRED":             "Lecture name must be 2 to 20 characters long.",
+	"LECTURE_ID_INVALID":                "Lecture number must be a number between 1000 and 9999.",
+	"LECTURE_CAPACITY_INVALID":          "Capacity must be between 1 and 30.",
+	"LECTURE_DAY_REQUIRED":              "Lecture day is required.",
+	"LECTURE_TIME_REQUIRED":             "Start and end times are required.",
+	"LECTURE_TIME_ORDER_INVALID":        "End time must be after start time.",
+	"LECTURE_NAME_DUPLICATE":            "A lecture with this name already exists in this term.",
+	"LECTURE_ID_DUPLICATE":              "A lecture with this number already exists.",
+	"LECTURE_CREDIT_INVALID":            "Credits must be between 1 and 6.",
+	"LECTURE_LIST_IS_EMPTY":             "The lecture could not be created.",
+	"LECTURE_DAY_INVALID":               "Day must be one of MON, TUE, WED, THU, FRI.",
+	"LECTURE_TIME_FORMAT_INVALID":       "Time must be in HH:MM format.",
+	"LECTURE_SORT_INVALID":              "Sort must be one of id, name, credit, capacity, start_time.",
+	"SORT_ORDER_INVALID":                "Order must be asc or desc.",
+	"PAGE_INVALID":                      "Page must be 1 or greater.",
+	"PAGE_SIZE_INVALID":                 "Page size must be between 1 and 100.",
+	"SEARCH_QUERY_REQUIRED":             "Please enter a search term.",
+	"LECTURE_UPDATE_EMPTY":              "There is nothing to change.",
+	"LECTURE_CAPACITY_BELOW_ENROLLMENT": "Capacity cannot be less than the current enrollment.",
+	"LECTURE_UPDATE_TIME_CONFLICT":      "The new schedule overlaps with other lectures of {count} enrolled student(s).",
+	"LECTURE_SLOTS_INVALID":             "A lecture must have between 1 and 5 meeting times.",
+	"LECTURE_SLOTS_OVERLAP":             "Meeting times of the same lecture cannot overlap.",
+	"LECTURE_TIME_GRANULARITY":          "Meeting times must be on the allowed minute interval.",
+	"LECTURE_TIME_OUTSIDE_HOURS":        "Meeting times must be within campus opening hours.",
+	"SCHEDULE_POLICY_INVALID":           "The schedule policy needs a positive minute interval and a closing time after the opening time.",
+
+	// Term 관련 예외
+	"TERM_ID_INVALID":                   "Term must be a year and semester (1 or 2), such as 2026-1 or 2026-2.",
+	"TERM_DATE_INVALID":                 "Term start and end dates must be in YYYY-MM-DD format.",
+	"TERM_DATE_ORDER_INVALID":           "Term end date must be after the start date.",
+	"TERM_DUPLICATE":                    "This term is already registered.",
+	"TERM_NOT_FOUND":                    "Term does not exist.",
+	"TERM_CLONE_SAME_TERM":              "Source and target terms must be different.",
+	"TERM_CLONE_CAPACITY_SCALE_INVALID": "Capacity scale must be greater than 0.",
+
+	// Room 관련 예외
+	"ROOM_ID_INVALID":                "Room number must be 2 to 20 characters of uppercase letters, digits, or -.",
+	"ROOM_NAME_INVALID":              "Room name must be 1 to 30 characters long.",
+	"ROOM_SEATS_INVALID":             "Seats must be between 1 and 500.",
+	"ROOM_UPDATE_EMPTY":              "There is nothing to change.",
+	"ROOM_DUPLICATE":                 "This room is already registered.",
+	"ROOM_NOT_FOUND":                 "Room does not exist.",
+	"ROOM_IN_USE":                    "A room with assigned lectures cannot be deleted.",
+	"ROOM_SEATS_BELOW_CAPACITY":      "Seats cannot be fewer than the capacity of an assigned lecture.",
+	"ROOM_DOUBLE_BOOKED":             "The room is already used by {lecture} at this time.",
+	"LECTURE_CAPACITY_EXCEEDS_SEATS": "Capacity cannot exceed the number of seats in the room.",
+
+	// Enrollment 관련 예외
+	"ENROLLMENT_LECTURE_ID_REQUIRED":       "Lecture number is required.",
+	"LECTURE_NOT_FOUND":                    "Lecture does not exist.",
+	"TIME_CONFLICT":                        "The schedule overlaps with {lecture}.",
+	"LECTURE_CAPACITY_EXCEEDED":            "The lecture is full.",
+	"CREDIT_LIMIT_EXCEEDED":                "Total credits cannot exceed 18.",
+	"LECTURE_VERSION_CONFLICT":             "The lecture was changed by another request.",
+	"LOCK_TIMEOUT":                         "Too many requests at once. Please try again shortly.",
+	"ENROLLMENT_NOT_FOUND":                 "Enrollment does not exist.",
+	"ENROLLMENT_STATUS_INVALID":            "Enrollment status must be one of ENROLLED, DROPPED, WITHDRAWN, WAITLISTED.",
+	"ENROLLMENT_STATUS_TRANSITION_INVALID": "This enrollment cannot be changed in its current status.",
+	"LECTURE_TERM_NOT_ACTIVE":              "You can only enroll in lectures of the current term.",
+
+	// Waitlist 관련 예외
+	"WAITLIST_LECTURE_NOT_FULL": "This lecture still has open seats. Please enroll directly.",
+	"WAITLIST_ALREADY_ENROLLED": "You are already enrolled in this lecture.",
+	"WAITLIST_DUPLICATE":        "You are already on the waitlist for this lecture.",
+	"WAITLIST_NOT_FOUND":        "You are not on the waitlist for this lecture.",
+
+	// Controller 관련 예외
+	"INVALID_REQUEST_BODY":  "The request body is invalid.",
+	"VALIDATION_FAILED":     "Some fields are invalid.",
+	"FIELD_INVALID":         "The value has an invalid format.",
+	"STUDENT_ID_NOT_NUMBER": "Student ID must be a number.",
+	"REPAIR_FLAG_INVALID":   "repair must be true or false.",
+	"INVALID_QUERY_PARAM":   "The query parameters are invalid.",
+	"REQUEST_TIMEOUT":       "The request timed out. Please try again shortly.",
+	"INTERNAL_ERROR":        "The request could not be processed. Please try again shortly.",
+	"SERVICE_UNAVAILABLE":   "The service is temporarily unavailable. Please try again shortly.",
+}
@@ -0,0 +1,103 @@
+package i18n
+
+import "golang-course-registration/common/constants"
+
+var koreanMessages = map[string]string{
+	// 요일
+	"day.MON": constants.MON,
+	"day.TUE": constants.TUE,
+	"day.WED": constants.WED,
+	"day.THU": constants.THU,
+	"day.FRI": constants.FRI,
+
+	// 공통
+	"site.title":           "강좌 등록 시스템",
+	"site.heading":         "수강 신청 시스템",
+	"common.select":        "선택",
+	"common.refresh":       "새로고침",
+	"common.loading":       "로딩 중...",
+	"common.delete":        "삭제",
+	"common.requestFailed": "요청 처리에 실패했습니다.",
+	"unit.credits":         "{count}학점",
+	"unit.people":          "{count}명",
+
+	// 강좌 항목
+	"lecture.id":                "강좌 번호",
+	"lecture.idColumn":          "강좌 ID",
+	"lecture.name":              "강좌명",
+	"lecture.capacity":          "정원",
+	"lecture.credit":            "학점",
+	"lecture.day":               "요일",
+	"lecture.startTime":         "시작 시간",
+	"lecture.endTime":           "종료 시간",
+	"lecture.slots":             "수업 시간",
+	"lecture.currentEnrollment": "수강 인원",
+	"lecture.empty":             "등록된 강좌가 없습니다.",
+
+	// 학생 항목
+	"student.idRequired": "학번을 입력해주세요.",
+	"student.idInvalid":  "학번은 1000~9999 사이의 숫자여야 합니다.",
+
+	// 메인 페이지
+	"index.title":               "홈",
+	"index.welcome":             "수강신청 시스템에 오신 것을 환영합니다",
+	"index.admin.heading":       "관리자 포털",
+	"index.admin.description":   "대시보드에서 강좌 등록하세요.",
+	"index.admin.link":          "관리자 대시보드로 이동",
+	"index.student.heading":     "수강생 포털",
+	"index.student.description": "학번을 입력하세요.",
+	"index.student.placeholder": "학번 입력",
+	"index.student.submit":      "이동",
+
+	// 관리자 대시보드
+	"admin.title":                  "관리자 대시보드",
+	"admin.intro":                  "강좌를 등록하고 관리하세요.",
+	"admin.create.heading":         "강좌 등록",
+	"admin.create.namePlaceholder": "고급 알고리즘",
+	"admin.create.submit":          "강좌 등록",
+	"admin.create.addSlot":         "수업 시간 추가",
+	"admin.create.removeSlot":      "삭제",
+	"admin.create.pending":         "등록 중입니다...",
+	"admin.create.failed":          "강좌 등록에 실패했습니다.",
+	"admin.create.success":         "강좌가 등록되었습니다.",
+	"admin.list.heading":           "등록된 강좌",
+	"admin.list.failed":            "강좌 목록을 불러오는데 실패했습니다.",
+	"admin.list.number":            "번호",
+	"admin.delete.confirm":         "\"{name}\" 강좌를 정말 삭제하시겠습니까?",
+	"admin.delete.pending":         "삭제 중입니다...",
+	"admin.delete.failed":          "강좌 삭제에 실패했습니다.",
+	"admin.delete.success":         "강좌가 삭제되었습니다.",
+	"admin.room.delete.success":    "강의실이 삭제되었습니다.",
+
+	// 수강생 대시보드
+	"client.title":                  "수강생 대시보드",
+	"client.currentStudent":         "접속한 학번 :",
+	"client.studentUnset":           "미지정",
+	"client.lectures.heading":       "강좌 목록",
+	"client.lectures.hint":          "각 강좌 옆의 \"수강신청\" 버튼을 클릭하여 신청하세요.",
+	"client.filter.allDays":         "전체 요일",
+	"client.filter.namePlaceholder": "강좌명 검색",
+	"client.filter.openOnly":        "정원이 남은 강좌만",
+	"client.filter.apply":           "검색",
+	"client.pager.prev":             "이전",
+	"client.pager.next":             "다음",
+	"client.pager.info":             "{page} / {total} 페이지 (총 {count}개)",
+	"client.enrollments.heading":    "내 수강신청 강좌",
+	"client.enrollments.empty":      "수강 신청 내역이 없습니다.",
+	"client.enroll.button":          "수강신청",
+	"client.enroll.studentRequired": "먼저 학번을 적용해주세요.",
+	"client.enroll.pending":         "수강신청 중입니다...",
+	"client.enroll.success":         "\"{name}\" 강좌 수강신청이 완료되었습니다.",
+	"client.cancel.studentRequired": "학번을 먼저 설정해주세요.",
+	"client.cancel.confirm":         "\"{name}\" 강좌의 수강신청을 취소하시겠습니까?",
+	"client.cancel.pending":         "취소 중입니다...",
+	"client.cancel.success":         "수강신청이 취소되었습니다.",
+	"client.waitlist.confirm":       "\"{name}\" 강좌의 정원이 찼습니다. 대기 명단에 등록하시겠습니까? 빈자리가 생기면 순서대로 자동 신청됩니다.",
+	"client.waitlist.joined":        "\"{name}\" 강좌 대기 명단에 등록되었습니다. (대기 순번 {position})",
+	"client.waitlist.leave.success": "수강 대기가 취소되었습니다.",
+
+	// 오류 페이지
+	"error.title":   "오류",
+	"error.heading": "오류가 발생했습니다",
+	"error.home":    "홈으로 돌아가기",
+}
@@ -1,17 +1,65 @@
 package config
 
 import (
+	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// 저장소 백엔드 종류
+const (
+	StorageSupabase = "supabase"
+	StorageMemory   = "memory"
+	StorageSQLite   = "sqlite"
+)
+
+// 잠금 백엔드 종류
+const (
+	LockMemory   = "memory"
+	LockPostgres = "postgres"
+)
+
+// OperationTimeouts 요청 종류별 처리 제한 시간 (조회, 변경, 수강신청/취소)
+type OperationTimeouts struct {
+	Read   time.Duration
+	Write  time.Duration
+	Enroll time.Duration
+}
+
 type Config struct {
-	Port string
-	Url  string
-	Key  string
+	Port           string
+	Url            string
+	Key            string
+	StorageBackend string
+	SQLitePath     string
+	DatabaseURL    string
+	LockBackend    string
+	LockTimeout    time.Duration
+
+	ReconcileInterval time.Duration
+	ReconcileRepair   bool
+
+	LectureCacheEnabled bool
+	LectureCacheTTL     time.Duration
+
+	LectureTimeUnit int    // 수업 시각의 분 단위
+	CampusOpenTime  string // 캠퍼스 운영 시작 시각 (HH:MM)
+	CampusCloseTime string // 캠퍼스 운영 종료 시각 (HH:MM)
+
+	ActiveTerm string // 수강신청을 받는 현재 학기 (예: 2026-2)
+
+	Timeouts OperationTimeouts
+
+	StoreRetryAttempts  int
+	StoreRetryBaseDelay time.Duration
+	StoreRetryMaxDelay  time.Duration
+	BreakerThreshold    int
+	BreakerCooldown     time.Duration
 }
 
 func Load() *Config {
@@ -20,9 +68,38 @@ func Load() *Config {
 	}
 
 	return &Config{
-		Port: os.Getenv("PORT"),
-		Url:  os.Getenv("SUPABASE_URL"),
-		Key:  os.Getenv("SUPABASE_ANON_KEY"),
+		Port:           os.Getenv("PORT"),
+		Url:            os.Getenv("SUPABASE_URL"),
+		Key:            os.Getenv("SUPABASE_ANON_KEY"),
+		StorageBackend: getEnv("STORAGE_BACKEND", StorageSupabase),
+		SQLitePath:     getEnv("SQLITE_PATH", "course_registration.db"),
+		DatabaseURL:    os.Getenv("DATABASE_URL"),
+		LockBackend:    getEnv("LOCK_BACKEND", LockMemory),
+		LockTimeout:    getDuration("LOCK_TIMEOUT", constants.LockTimeoutDefault),
+
+		ReconcileInterval: getDuration("RECONCILE_INTERVAL", 0),
+		ReconcileRepair:   getBool("RECONCILE_REPAIR", false),
+
+		LectureCacheEnabled: getBool("LECTURE_CACHE", true),
+		LectureCacheTTL:     getDuration("LECTURE_CACHE_TTL", constants.LectureCacheTTLDefault),
+
+		LectureTimeUnit: getInt("LECTURE_TIME_UNIT", constants.LectureTimeUnitDefault),
+		CampusOpenTime:  getEnv("CAMPUS_OPEN_TIME", constants.CampusOpenTimeDefault),
+		CampusCloseTime: getEnv("CAMPUS_CLOSE_TIME", constants.CampusCloseTimeDefault),
+
+		ActiveTerm: getEnv("ACTIVE_TERM", constants.DefaultTermID),
+
+		Timeouts: OperationTimeouts{
+			Read:   getDuration("READ_TIMEOUT", constants.ReadTimeoutDefault),
+			Write:  getDuration("WRITE_TIMEOUT", constants.WriteTimeoutDefault),
+			Enroll: getDuration("ENROLL_TIMEOUT", constants.EnrollTimeoutDefault),
+		},
+
+		StoreRetryAttempts:  getInt("STORE_RETRY_ATTEMPTS", constants.StoreRetryAttemptsDefault),
+		StoreRetryBaseDelay: getDuration("STORE_RETRY_BASE_DELAY", constants.StoreRetryBaseDelayDefault),
+		StoreRetryMaxDelay:  getDuration("STORE_RETRY_MAX_DELAY", constants.StoreRetryMaxDelayDefault),
+		BreakerThreshold:    getInt("BREAKER_THRESHOLD", constants.BreakerThresholdDefault),
+		BreakerCooldown:     getDuration("BREAKER_COOLDOWN", constants.BreakerCooldownDefault),
 	}
 }
 
@@ -32,3 +109,24 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	if v, err := time.ParseDuration(os.Getenv(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
+func getInt(key string, defaultValue int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
+func getBool(key string, defaultValue bool) bool {
+	if v, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return v
+	}
+	return defaultValue
+}
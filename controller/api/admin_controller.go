@@ -2,6 +2,8 @@ package api
 
 import (
 	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
+	"golang-course-registration/config"
 	"golang-course-registration/controller/dto"
 	"golang-course-registration/service"
 	"net/http"
@@ -11,55 +13,232 @@ import (
 )
 
 type AdminController struct {
-	lectureService service.LectureService
+	lectureService     service.LectureService
+	enrollmentService  service.EnrollmentService
+	maintenanceService service.MaintenanceService
+	termService        service.TermService
+	roomService        service.RoomService
+	timeouts           config.OperationTimeouts
 }
 
-func NewAdminController(lectureService service.LectureService) *AdminController {
-	return &AdminController{lectureService: lectureService}
+func NewAdminController(
+	lectureService service.LectureService,
+	enrollmentService service.EnrollmentService,
+	maintenanceService service.MaintenanceService,
+	termService service.TermService,
+	roomService service.RoomService,
+	timeouts config.OperationTimeouts,
+) *AdminController {
+	return &AdminController{
+		lectureService:     lectureService,
+		enrollmentService:  enrollmentService,
+		maintenanceService: maintenanceService,
+		termService:        termService,
+		roomService:        roomService,
+		timeouts:           timeouts,
+	}
 }
 
 func (c *AdminController) RegisterRoutes(group *echo.Group) {
-	group.POST("/lectures", c.CreateLecture)
-	group.GET("/lectures", c.ListLectures)
-	group.DELETE("/lectures/:id", c.DeleteLecture)
+	read := withTimeout(c.timeouts.Read)
+	write := withTimeout(c.timeouts.Write)
+
+	group.POST("/terms", c.CreateTerm, write)
+	group.GET("/terms", c.ListTerms, read)
+	group.POST("/terms/:termId/clone", c.CloneTerm, write)
+
+	group.POST("/rooms", c.CreateRoom, write)
+	group.GET("/rooms", c.ListRooms, read)
+	group.PATCH("/rooms/:roomId", c.UpdateRoom, write)
+	group.DELETE("/rooms/:roomId", c.DeleteRoom, write)
+
+	group.POST("/lectures", c.CreateLecture, write)
+	group.GET("/lectures", c.ListLectures, read)
+	group.PATCH("/lectures/:id", c.UpdateLecture, write)
+	group.DELETE("/lectures/:id", c.DeleteLecture, write)
+
+	group.GET("/locks/stats", c.LockStats)
+	group.POST("/maintenance/reconcile", c.Reconcile, write)
+	group.GET("/cache/stats", c.CacheStats)
+}
+
+// CreateTerm 학기 등록
+func (c *AdminController) CreateTerm(ctx echo.Context) error {
+	var req dto.CreateTermRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	term, err := c.termService.Create(ctx.Request().Context(), req)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, successResponse(term))
+}
+
+// ListTerms 학기 목록 조회 (현재 학기 표시)
+func (c *AdminController) ListTerms(ctx echo.Context) error {
+	terms, err := c.termService.List(ctx.Request().Context())
+	if err != nil {
+		return respondError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, successResponse(terms))
+}
+
+// CloneTerm 원본 학기의 강좌를 termId 학기로 복사 (dry_run이면 저장하지 않고 계획만 반환)
+func (c *AdminController) CloneTerm(ctx echo.Context) error {
+	var req dto.CloneTermRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	result, err := c.lectureService.CloneTerm(ctx.Request().Context(), req)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	status := http.StatusCreated
+	if result.DryRun {
+		status = http.StatusOK
+	}
+	return ctx.JSON(status, successResponse(result))
+}
+
+// CreateRoom 강의실 등록
+func (c *AdminController) CreateRoom(ctx echo.Context) error {
+	var req dto.CreateRoomRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	room, err := c.roomService.Create(ctx.Request().Context(), req)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, successResponse(room))
+}
+
+// ListRooms 강의실 목록 조회 (강의실 번호 순)
+func (c *AdminController) ListRooms(ctx echo.Context) error {
+	rooms, err := c.roomService.List(ctx.Request().Context())
+	if err != nil {
+		return respondError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, successResponse(rooms))
 }
 
-// CreateLecture 강좌 등록
+// UpdateRoom 강의실 이름, 좌석 수 변경 (보낸 항목만 변경)
+func (c *AdminController) UpdateRoom(ctx echo.Context) error {
+	var req dto.UpdateRoomRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	room, err := c.roomService.Update(ctx.Request().Context(), ctx.Param("roomId"), req)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(room))
+}
+
+// DeleteRoom 강의실 삭제 (강좌가 배정되어 있으면 거부)
+func (c *AdminController) DeleteRoom(ctx echo.Context) error {
+	err := c.roomService.Delete(ctx.Request().Context(), ctx.Param("roomId"))
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(map[string]string{"message": i18n.T(requestLocale(ctx), "admin.room.delete.success")}))
+}
+
+// CreateLecture 강좌 등록 (학기를 생략하면 현재 학기)
 func (c *AdminController) CreateLecture(ctx echo.Context) error {
 	var req dto.CreateLectureRequest
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrInvalidRequestBody))
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
 	}
 
-	_, err := c.lectureService.Create(req)
+	_, err := c.lectureService.Create(ctx.Request().Context(), req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+		return respondError(ctx, err)
 	}
 
-	return ctx.JSON(http.StatusCreated, successResponse(map[string]string{"message": "강좌가 등록되었습니다"}))
+	return ctx.JSON(http.StatusCreated, successResponse(map[string]string{"message": i18n.T(requestLocale(ctx), "admin.create.success")}))
 }
 
-// ListLectures 강좌 목록 조회
+// ListLectures 강좌 목록 조회 (term으로 학기 필터, 생략하면 모든 학기)
 func (c *AdminController) ListLectures(ctx echo.Context) error {
-	lectures, err := c.lectureService.List()
+	lectures, err := c.lectureService.List(ctx.Request().Context(), ctx.QueryParam("term"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, errorResponse(exception.ErrLectureListFailed))
+		return respondError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, successResponse(lectures))
 }
 
+// UpdateLecture 강좌 정보 변경 (보낸 항목만 변경)
+func (c *AdminController) UpdateLecture(ctx echo.Context) error {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		return respondError(ctx, exception.ErrLectureIDInvalid)
+	}
+
+	var req dto.UpdateLectureRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	lecture, err := c.lectureService.Update(ctx.Request().Context(), id, req)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(lecture))
+}
+
 // DeleteLecture 강좌 삭제
 func (c *AdminController) DeleteLecture(ctx echo.Context) error {
 	idStr := ctx.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil || id <= 0 {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrLectureIDInvalid))
+		return respondError(ctx, exception.ErrLectureIDInvalid)
 	}
 
-	err = c.lectureService.Delete(id)
+	err = c.lectureService.Delete(ctx.Request().Context(), id)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+		return respondError(ctx, err)
 	}
 
-	return ctx.JSON(http.StatusOK, successResponse(map[string]string{"message": "강좌가 삭제되었습니다"}))
+	return ctx.JSON(http.StatusOK, successResponse(map[string]string{"message": i18n.T(requestLocale(ctx), "admin.delete.success")}))
+}
+
+// LockStats 학생/강좌별 잠금 경합 통계 조회
+func (c *AdminController) LockStats(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, successResponse(c.enrollmentService.LockStats()))
+}
+
+// Reconcile 강좌별 수강 인원 점검 (repair=true 이면 보정)
+func (c *AdminController) Reconcile(ctx echo.Context) error {
+	repair := false
+	if repairStr := ctx.QueryParam("repair"); repairStr != "" {
+		parsed, err := strconv.ParseBool(repairStr)
+		if err != nil {
+			return respondError(ctx, exception.ErrRepairFlagInvalid)
+		}
+		repair = parsed
+	}
+
+	result, err := c.maintenanceService.Reconcile(ctx.Request().Context(), repair)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(result))
+}
+
+// CacheStats 강좌 캐시 적중/실패 통계 조회
+func (c *AdminController) CacheStats(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, successResponse(c.maintenanceService.CacheStats()))
 }
@@ -2,6 +2,8 @@ package api
 
 import (
 	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
+	"golang-course-registration/config"
 	"golang-course-registration/controller/dto"
 	"golang-course-registration/service"
 	"net/http"
@@ -14,50 +16,78 @@ type ClientController struct {
 	studentService    service.StudentService
 	lectureService    service.LectureService
 	enrollmentService service.EnrollmentService
+	timeouts          config.OperationTimeouts
 }
 
 func NewClientController(
 	studentService service.StudentService,
 	lectureService service.LectureService,
 	enrollmentService service.EnrollmentService,
+	timeouts config.OperationTimeouts,
 ) *ClientController {
 	return &ClientController{
 		studentService:    studentService,
 		lectureService:    lectureService,
 		enrollmentService: enrollmentService,
+		timeouts:          timeouts,
 	}
 }
 
 func (c *ClientController) RegisterRoutes(group *echo.Group) {
-	group.POST("/students", c.CreateStudent)
+	read := withTimeout(c.timeouts.Read)
+	write := withTimeout(c.timeouts.Write)
+	enroll := withTimeout(c.timeouts.Enroll)
 
-	group.GET("/lectures", c.ListLectures)
+	group.POST("/students", c.CreateStudent, write)
 
-	group.POST("/enrollments", c.Enroll)
-	group.GET("/enrollments/:studentId", c.ListEnrollmentsByStudent)
-	group.DELETE("/enrollments/:studentId/:lectureId", c.CancelEnrollment)
+	group.GET("/lectures", c.ListLectures, read)
+	group.GET("/lectures/search", c.SearchLectures, read)
+
+	group.POST("/enrollments", c.Enroll, enroll)
+	group.GET("/enrollments/:studentId", c.ListEnrollmentsByStudent, read)
+	group.GET("/enrollments/:studentId/history", c.ListEnrollmentHistory, read)
+	group.DELETE("/enrollments/:studentId/:lectureId", c.CancelEnrollment, enroll)
+
+	group.POST("/waitlist", c.JoinWaitlist, enroll)
+	group.GET("/waitlist/:studentId/:lectureId", c.WaitlistPosition, read)
+	group.DELETE("/waitlist/:studentId/:lectureId", c.LeaveWaitlist, enroll)
 }
 
 // CreateStudent 학생 등록
 func (c *ClientController) CreateStudent(ctx echo.Context) error {
 	var req dto.CreateStudentRequest
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrInvalidRequestBody))
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
 	}
 
-	student, err := c.studentService.Register(req.ID)
+	student, err := c.studentService.Register(ctx.Request().Context(), req.ID)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, successResponse(student))
 }
 
-// ListLectures 강좌 목록 조회
+// ListLectures 강좌 목록 조회 (필터, 정렬, 페이지)
 func (c *ClientController) ListLectures(ctx echo.Context) error {
-	lectures, err := c.lectureService.List()
+	var req dto.LectureListRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &req); err != nil {
+		return respondError(ctx, exception.ErrInvalidQueryParam)
+	}
+
+	page, err := c.lectureService.ListPage(ctx.Request().Context(), req)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, errorResponse(exception.ErrLectureListFailed))
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, pagedResponse(page.Lectures, page.Page, page.Size, page.TotalCount, page.TotalPages))
+}
+
+// SearchLectures 강좌명 검색 (term으로 학기 지정, 생략하면 현재 학기)
+func (c *ClientController) SearchLectures(ctx echo.Context) error {
+	lectures, err := c.lectureService.Search(ctx.Request().Context(), ctx.QueryParam("q"), ctx.QueryParam("term"))
+	if err != nil {
+		return respondError(ctx, err)
 	}
 	return ctx.JSON(http.StatusOK, successResponse(lectures))
 }
@@ -65,48 +95,124 @@ func (c *ClientController) ListLectures(ctx echo.Context) error {
 // Enroll 수강신청
 func (c *ClientController) Enroll(ctx echo.Context) error {
 	var req dto.EnrollRequest
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrInvalidRequestBody))
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
 	}
 
-	enrollment, err := c.enrollmentService.Enroll(req.StudentID, req.LectureID)
+	enrollment, err := c.enrollmentService.Enroll(ctx.Request().Context(), req.StudentID, req.LectureID)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusCreated, successResponse(enrollment))
 }
 
-// ListEnrollmentsByStudent 학생의 수강신청 내역 조회
+// ListEnrollmentsByStudent 학생의 수강신청 내역 조회 (term으로 학기 지정, 생략하면 현재 학기)
 func (c *ClientController) ListEnrollmentsByStudent(ctx echo.Context) error {
 	studentID, _ := strconv.Atoi(ctx.Param("studentId"))
-	lectures, err := c.enrollmentService.ListByStudent(studentID)
+	lectures, err := c.enrollmentService.ListByStudent(ctx.Request().Context(), studentID, ctx.QueryParam("term"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+		return respondError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusOK, successResponse(lectures))
 }
 
+// ListEnrollmentHistory 학생의 수강신청 이력 조회 (취소, 대기 제외 포함, status로 상태 필터, term으로 학기 지정)
+func (c *ClientController) ListEnrollmentHistory(ctx echo.Context) error {
+	studentID, err := strconv.Atoi(ctx.Param("studentId"))
+	if err != nil || studentID <= 0 {
+		return respondError(ctx, exception.ErrStudentIDNotNumber)
+	}
+
+	var req dto.EnrollmentHistoryRequest
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &req); err != nil {
+		return respondError(ctx, exception.ErrInvalidQueryParam)
+	}
+	statuses, err := req.Statuses()
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	history, err := c.enrollmentService.History(ctx.Request().Context(), studentID, req.Term, statuses...)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(history))
+}
+
 // CancelEnrollment 수강신청 취소
 func (c *ClientController) CancelEnrollment(ctx echo.Context) error {
-	studentIDStr := ctx.Param("studentId")
-	lectureIDStr := ctx.Param("lectureId")
+	studentID, lectureID, err := studentLectureParams(ctx)
+	if err != nil {
+		return respondError(ctx, err)
+	}
 
-	studentID, err := strconv.Atoi(studentIDStr)
-	if err != nil || studentID <= 0 {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrStudentIDNotNumber))
+	err = c.enrollmentService.Cancel(ctx.Request().Context(), studentID, lectureID)
+	if err != nil {
+		return respondError(ctx, err)
 	}
 
-	lectureID, err := strconv.Atoi(lectureIDStr)
-	if err != nil || lectureID <= 0 {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(exception.ErrLectureIDInvalid))
+	return ctx.JSON(http.StatusOK, successResponse(i18n.T(requestLocale(ctx), "client.cancel.success")))
+}
+
+// JoinWaitlist 정원이 찬 강좌의 수강 대기 등록
+func (c *ClientController) JoinWaitlist(ctx echo.Context) error {
+	var req dto.WaitlistRequest
+	if err := bindRequest(ctx, &req); err != nil {
+		return respondError(ctx, err)
+	}
+
+	waitlist, err := c.enrollmentService.JoinWaitlist(ctx.Request().Context(), req.StudentID, req.LectureID)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, successResponse(waitlist))
+}
+
+// WaitlistPosition 수강 대기 순번 조회
+func (c *ClientController) WaitlistPosition(ctx echo.Context) error {
+	studentID, lectureID, err := studentLectureParams(ctx)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	waitlist, err := c.enrollmentService.WaitlistPosition(ctx.Request().Context(), studentID, lectureID)
+	if err != nil {
+		return respondError(ctx, err)
 	}
 
-	err = c.enrollmentService.Cancel(studentID, lectureID)
+	return ctx.JSON(http.StatusOK, successResponse(waitlist))
+}
+
+// LeaveWaitlist 수강 대기 취소
+func (c *ClientController) LeaveWaitlist(ctx echo.Context) error {
+	studentID, lectureID, err := studentLectureParams(ctx)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+		return respondError(ctx, err)
+	}
+
+	err = c.enrollmentService.LeaveWaitlist(ctx.Request().Context(), studentID, lectureID)
+	if err != nil {
+		return respondError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, successResponse(i18n.T(requestLocale(ctx), "client.waitlist.leave.success")))
+}
+
+// studentLectureParams 경로의 학번(studentId)과 강좌번호(lectureId)
+func studentLectureParams(ctx echo.Context) (int, int, error) {
+	studentID, err := strconv.Atoi(ctx.Param("studentId"))
+	if err != nil || studentID <= 0 {
+		return 0, 0, exception.ErrStudentIDNotNumber
+	}
+
+	lectureID, err := strconv.Atoi(ctx.Param("lectureId"))
+	if err != nil || lectureID <= 0 {
+		return 0, 0, exception.ErrLectureIDInvalid
 	}
 
-	return ctx.JSON(http.StatusOK, successResponse("수강신청이 취소되었습니다"))
+	return studentID, lectureID, nil
 }
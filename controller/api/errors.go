@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/common/exception"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// errorStatuses 에러 분류별 HTTP 상태 코드
+var errorStatuses = map[exception.Kind]int{
+	exception.KindBadRequest:  http.StatusBadRequest,
+	exception.KindInvalid:     http.StatusUnprocessableEntity,
+	exception.KindNotFound:    http.StatusNotFound,
+	exception.KindConflict:    http.StatusConflict,
+	exception.KindUnavailable: http.StatusServiceUnavailable,
+	exception.KindTimeout:     http.StatusGatewayTimeout,
+}
+
+// respondError 에러를 분류에 맞는 상태 코드와 코드/메시지 응답으로 변환
+// 분류되지 않은 에러는 내부 정보를 숨기고 500으로 응답하며, 원인은 로그로 남김
+func respondError(ctx echo.Context, err error) error {
+	domainErr := classifyError(err)
+	status, ok := errorStatuses[domainErr.Kind]
+	if !ok {
+		log.Printf("%s %s 처리 실패 : %v", ctx.Request().Method, ctx.Path(), err)
+		domainErr, status = exception.ErrInternal, http.StatusInternalServerError
+	}
+	return ctx.JSON(status, errorResponse(domainErr, requestLocale(ctx)))
+}
+
+func classifyError(err error) *exception.Error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return exception.ErrRequestTimeout
+	}
+
+	var domainErr *exception.Error
+	if errors.As(err, &domainErr) {
+		return domainErr
+	}
+	return exception.ErrInternal
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/infrastructure/resilience"
+	"golang-course-registration/repository"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRespondError(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"대상 없음", exception.ErrLectureNotFound, http.StatusNotFound, "LECTURE_NOT_FOUND"},
+		{"정원 초과", exception.ErrLectureCapacityExceeded, http.StatusConflict, "LECTURE_CAPACITY_EXCEEDED"},
+		{"시간 충돌 (강좌명 포함)", exception.TimeConflict("데이터베이스"), http.StatusConflict, "TIME_CONFLICT"},
+		{"입력 값 검증 실패", exception.ErrLectureCreditInvalid, http.StatusUnprocessableEntity, "LECTURE_CREDIT_INVALID"},
+		{"요청 형식 오류", exception.ErrInvalidRequestBody, http.StatusBadRequest, "INVALID_REQUEST_BODY"},
+		{"버전 충돌", &repository.ConflictError{LectureID: 1001}, http.StatusConflict, "LECTURE_VERSION_CONFLICT"},
+		{"저장소 장애", resilience.Unavailable(errors.New("connection refused")), http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE"},
+		{"처리 제한 시간 초과", fmt.Errorf("조회 실패: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, "REQUEST_TIMEOUT"},
+		{"분류되지 않은 에러", errors.New("pq: relation \"lectures\" does not exist"), http.StatusInternalServerError, "INTERNAL_ERROR"},
+		{"내부 에러", exception.ErrLectureListIsEmpty, http.StatusInternalServerError, "INTERNAL_ERROR"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			// when
+			_ = respondError(ctx, tc.err)
+
+			// then
+			var body response
+			_ = json.Unmarshal(rec.Body.Bytes(), &body)
+			if rec.Code != tc.expectedStatus || body.Error == nil || body.Error.Code != tc.expectedCode {
+				t.Errorf("기대 : %d %s, 결과 : %d %s", tc.expectedStatus, tc.expectedCode, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	t.Run("500 응답에는 내부 에러 메시지를 노출하지 않음", func(t *testing.T) {
+		// given
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+		// when
+		_ = respondError(ctx, errors.New("dial tcp 10.0.0.1:5432: connection refused"))
+
+		// then
+		var body response
+		_ = json.Unmarshal(rec.Body.Bytes(), &body)
+		if body.Error == nil || body.Error.Message != exception.ErrInternal.Message {
+			t.Errorf("기대 : %s, 결과 : %s", exception.ErrInternal.Message, rec.Body.String())
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/resilience"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthController struct {
+	storage string
+	breaker *resilience.Breaker
+}
+
+// NewHealthController breaker가 nil이면 (외부 저장소를 쓰지 않으면) 회로 차단기 상태 없이 응답
+func NewHealthController(storage string, breaker *resilience.Breaker) *HealthController {
+	return &HealthController{storage: storage, breaker: breaker}
+}
+
+func (c *HealthController) RegisterRoutes(group *echo.Group) {
+	group.GET("/health", c.Health)
+}
+
+// Health 서버와 저장소 회로 차단기 상태 조회, 회로가 열려 있으면 degraded
+func (c *HealthController) Health(ctx echo.Context) error {
+	response := dto.HealthResponse{Status: dto.HealthOK, Storage: c.storage}
+	if c.breaker != nil {
+		stats := c.breaker.Stats()
+		response.Breaker = &stats
+		if stats.State == resilience.StateOpen {
+			response.Status = dto.HealthDegraded
+		}
+	}
+	return ctx.JSON(http.StatusOK, successResponse(response))
+}
@@ -0,0 +1,300 @@
+package api
+
+import (
+	_ "embed"
+	"golang-course-registration/model"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//go:embed swagger_ui.html
+var swaggerUIPage string
+
+// openAPIDocument OpenAPI 3 문서 중 이 서버가 사용하는 항목
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `json:"schemas"`
+}
+
+type openAPIOperation struct {
+	Tags        []string                    `json:"tags,omitempty"`
+	Summary     string                      `json:"summary"`
+	OperationID string                      `json:"operationId"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// schemaEnums 문자열 타입 중 허용 값이 정해진 타입
+var schemaEnums = map[reflect.Type][]string{
+	reflect.TypeOf(model.Day("")): {
+		string(model.Monday), string(model.Tuesday), string(model.Wednesday), string(model.Thursday), string(model.Friday),
+	},
+	reflect.TypeOf(model.EnrollmentStatus("")): {
+		string(model.EnrollmentStatusEnrolled), string(model.EnrollmentStatusDropped),
+		string(model.EnrollmentStatusWithdrawn), string(model.EnrollmentStatusWaitlisted),
+	},
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaBuilder DTO 구조체의 json 태그로 스키마를 만들고, 구조체는 components에 한 번만 등록
+type schemaBuilder struct {
+	schemas map[string]*openAPISchema
+}
+
+func (b *schemaBuilder) schemaOf(value any) *openAPISchema {
+	if value == nil {
+		return &openAPISchema{}
+	}
+	return b.schemaFor(reflect.TypeOf(value))
+}
+
+func (b *schemaBuilder) schemaFor(t reflect.Type) *openAPISchema {
+	if enum, ok := schemaEnums[t]; ok {
+		return &openAPISchema{Type: "string", Enum: enum}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := b.schemaFor(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		schema.Nullable = true
+		return schema
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &openAPISchema{Type: "number"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &openAPISchema{Type: "array", Items: b.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: b.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t == timeType {
+			return &openAPISchema{Type: "string", Format: "date-time"}
+		}
+		if _, ok := b.schemas[t.Name()]; !ok {
+			b.schemas[t.Name()] = &openAPISchema{} // 자기 참조 구조체 대비, 채우기 전에 자리를 먼저 차지
+			b.schemas[t.Name()] = b.structSchema(t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		return &openAPISchema{}
+	}
+}
+
+// structSchema encoding/json과 같은 규칙으로 필드 이름을 정하고, 임베딩한 구조체의 필드는 펼침
+// omitempty, omitzero가 없는 필드는 항상 응답에 포함되므로 required로 표시
+func (b *schemaBuilder) structSchema(t reflect.Type) *openAPISchema {
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			embedded := b.structSchema(field.Type)
+			for property, propertySchema := range embedded.Properties {
+				schema.Properties[property] = propertySchema
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = b.schemaFor(field.Type)
+		if !strings.Contains(options, "omitempty") && !strings.Contains(options, "omitzero") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// queryParameters query 태그가 있는 요청 구조체를 쿼리 파라미터 목록으로 변환
+func (b *schemaBuilder) queryParameters(value any) []openAPIParameter {
+	t := reflect.TypeOf(value)
+	parameters := make([]openAPIParameter, 0, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name := field.Tag.Get("query")
+		if name == "" {
+			continue
+		}
+		parameters = append(parameters, openAPIParameter{Name: name, In: "query", Schema: b.schemaFor(field.Type)})
+	}
+	return parameters
+}
+
+// echoPathParam echo 경로의 :name 자리
+var echoPathParam = regexp.MustCompile(`:(\w+)`)
+
+// openAPIPath echo 경로(/lectures/:id)를 OpenAPI 경로(/lectures/{id})로 변환
+func openAPIPath(path string) string {
+	return echoPathParam.ReplaceAllString(path, "{$1}")
+}
+
+// buildOpenAPIDocument apiOperations와 DTO 타입으로 문서를 생성
+func buildOpenAPIDocument() *openAPIDocument {
+	builder := &schemaBuilder{schemas: map[string]*openAPISchema{}}
+	document := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       "수강신청 시스템 API",
+			Description: "에러 메시지는 Accept-Language 헤더 또는 lang 쿼리 파라미터(ko, en)의 언어로 반환합니다.",
+			Version:     "1.0.0",
+		},
+		Paths:      map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{Schemas: builder.schemas},
+	}
+
+	errorSchema := builder.schemaOf(errorEnvelope{})
+	for _, op := range apiOperations {
+		operation := &openAPIOperation{
+			Tags:        []string{op.Tag},
+			Summary:     op.Summary,
+			OperationID: op.OperationID,
+			Responses:   map[string]*openAPIResponse{},
+		}
+
+		for _, param := range echoPathParam.FindAllStringSubmatch(op.Path, -1) {
+			if slices.ContainsFunc(op.Parameters, func(p openAPIParameter) bool { return p.In == "path" && p.Name == param[1] }) {
+				continue
+			}
+			operation.Parameters = append(operation.Parameters, openAPIParameter{
+				Name: param[1], In: "path", Required: true, Schema: &openAPISchema{Type: "integer"},
+			})
+		}
+		if op.Query != nil {
+			operation.Parameters = append(operation.Parameters, builder.queryParameters(op.Query)...)
+		}
+		operation.Parameters = append(operation.Parameters, op.Parameters...)
+
+		if op.Body != nil {
+			operation.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  map[string]openAPIMediaType{echo.MIMEApplicationJSON: {Schema: builder.schemaOf(op.Body)}},
+			}
+		}
+
+		success := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{
+			"success": {Type: "boolean"},
+			"data":    builder.schemaOf(op.Data),
+		}, Required: []string{"success"}}
+		if op.Paged {
+			success.Properties["meta"] = builder.schemaOf(pageMeta{})
+		}
+		operation.Responses[strconv.Itoa(op.Status)] = &openAPIResponse{
+			Description: http.StatusText(op.Status),
+			Content:     map[string]openAPIMediaType{echo.MIMEApplicationJSON: {Schema: success}},
+		}
+		for _, status := range op.Errors {
+			operation.Responses[strconv.Itoa(status)] = &openAPIResponse{
+				Description: http.StatusText(status),
+				Content:     map[string]openAPIMediaType{echo.MIMEApplicationJSON: {Schema: errorSchema}},
+			}
+		}
+
+		path := openAPIPath(op.Path)
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]*openAPIOperation{}
+		}
+		document.Paths[path][strings.ToLower(op.Method)] = operation
+	}
+	return document
+}
+
+// errorEnvelope 실패 응답 형식 (response 중 실패 시 채우는 필드만)
+type errorEnvelope struct {
+	Success bool     `json:"success"`
+	Error   apiError `json:"error"`
+}
+
+type OpenAPIController struct {
+	document *openAPIDocument
+}
+
+// NewOpenAPIController 문서는 생성 시 한 번 만들어 두고 그대로 응답
+func NewOpenAPIController() *OpenAPIController {
+	return &OpenAPIController{document: buildOpenAPIDocument()}
+}
+
+func (c *OpenAPIController) RegisterRoutes(group *echo.Group) {
+	group.GET("/openapi.json", c.Spec)
+	group.GET("/docs", c.SwaggerUI)
+}
+
+// Spec OpenAPI 문서 조회
+func (c *OpenAPIController) Spec(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, c.document)
+}
+
+// SwaggerUI /api/openapi.json을 읽는 Swagger UI 페이지
+func (c *OpenAPIController) SwaggerUI(ctx echo.Context) error {
+	return ctx.HTML(http.StatusOK, swaggerUIPage)
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/repository"
+	"net/http"
+)
+
+// apiOperation 문서화할 API 하나, 요청/응답 스키마는 DTO 값의 타입으로 생성
+type apiOperation struct {
+	Method      string
+	Path        string // echo 경로 (:name 자리는 정수 경로 파라미터, 다른 타입이면 Parameters에 In: "path"로 지정)
+	Tag         string
+	Summary     string
+	OperationID string
+	Query       any // query 태그가 있는 요청 구조체
+	Parameters  []openAPIParameter
+	Body        any
+	Status      int
+	Data        any
+	Paged       bool
+	Errors      []int
+}
+
+// termParameter 학기 필터 (예: 2026-2)
+func termParameter(description string) openAPIParameter {
+	return openAPIParameter{Name: "term", In: "query", Description: description, Schema: &openAPISchema{Type: "string"}}
+}
+
+// roomIDParameter 강의실 번호 경로 파라미터 (예: ENG-301)
+var roomIDParameter = openAPIParameter{
+	Name: "roomId", In: "path", Required: true, Description: "강의실 번호 (예: ENG-301)", Schema: &openAPISchema{Type: "string"},
+}
+
+// apiOperations 라우트를 추가/변경하면 함께 수정 (누락은 TestOpenAPIDocument가 검출)
+var apiOperations = []apiOperation{
+	{
+		Method: http.MethodGet, Path: "/api/v1/health", Tag: "health",
+		Summary: "서버 및 저장소 회로 차단기 상태 조회", OperationID: "getHealth",
+		Status: http.StatusOK, Data: dto.HealthResponse{},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/client/students", Tag: "client",
+		Summary: "학생 등록", OperationID: "createStudent",
+		Body:   dto.CreateStudentRequest{},
+		Status: http.StatusCreated, Data: dto.StudentResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/client/lectures", Tag: "client",
+		Summary: "강좌 목록 조회 (필터, 정렬, 페이지)", OperationID: "listLecturePage",
+		Query:  dto.LectureListRequest{},
+		Status: http.StatusOK, Data: []dto.LectureResponse{}, Paged: true,
+		Errors: []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/client/lectures/search", Tag: "client",
+		Summary: "강좌명 검색 (초성, 오타 허용)", OperationID: "searchLectures",
+		Parameters: []openAPIParameter{
+			{Name: "q", In: "query", Required: true, Description: "검색어", Schema: &openAPISchema{Type: "string"}},
+			termParameter("학기, 생략하면 현재 학기"),
+		},
+		Status: http.StatusOK, Data: []dto.LectureSearchResponse{},
+		Errors: []int{http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/client/enrollments", Tag: "client",
+		Summary: "수강신청", OperationID: "enroll",
+		Body:   dto.EnrollRequest{},
+		Status: http.StatusCreated, Data: dto.EnrollmentResponse{},
+		Errors: []int{
+			http.StatusBadRequest, http.StatusNotFound, http.StatusConflict,
+			http.StatusUnprocessableEntity, http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+		},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/client/enrollments/:studentId", Tag: "client",
+		Summary: "학생의 수강신청 강좌 목록 조회", OperationID: "listEnrollmentsByStudent",
+		Parameters: []openAPIParameter{termParameter("학기, 생략하면 현재 학기")},
+		Status:     http.StatusOK, Data: []dto.LectureResponse{},
+		Errors: []int{http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/client/enrollments/:studentId/history", Tag: "client",
+		Summary: "학생의 수강신청 이력 조회 (상태 필터)", OperationID: "listEnrollmentHistory",
+		Query:  dto.EnrollmentHistoryRequest{},
+		Status: http.StatusOK, Data: []dto.EnrollmentResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/client/enrollments/:studentId/:lectureId", Tag: "client",
+		Summary: "수강신청 취소 (이력은 DROPPED 상태로 유지)", OperationID: "cancelEnrollment",
+		Status: http.StatusOK, Data: "",
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/client/waitlist", Tag: "client",
+		Summary: "정원이 찬 강좌의 수강 대기 등록", OperationID: "joinWaitlist",
+		Body:   dto.WaitlistRequest{},
+		Status: http.StatusCreated, Data: dto.WaitlistResponse{},
+		Errors: []int{
+			http.StatusBadRequest, http.StatusNotFound, http.StatusConflict,
+			http.StatusUnprocessableEntity, http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+		},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/client/waitlist/:studentId/:lectureId", Tag: "client",
+		Summary: "수강 대기 순번 조회", OperationID: "getWaitlistPosition",
+		Status: http.StatusOK, Data: dto.WaitlistResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/client/waitlist/:studentId/:lectureId", Tag: "client",
+		Summary: "수강 대기 취소", OperationID: "leaveWaitlist",
+		Status: http.StatusOK, Data: "",
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/terms", Tag: "admin",
+		Summary: "학기 등록", OperationID: "createTerm",
+		Body:   dto.CreateTermRequest{},
+		Status: http.StatusCreated, Data: dto.TermResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/terms", Tag: "admin",
+		Summary: "학기 목록 조회 (현재 학기 표시)", OperationID: "listTerms",
+		Status: http.StatusOK, Data: []dto.TermResponse{},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/terms/:termId/clone", Tag: "admin",
+		Summary: "원본 학기의 강좌를 학기로 복사 (dry_run이면 계획만 반환)", OperationID: "cloneTerm",
+		Parameters: []openAPIParameter{
+			{Name: "termId", In: "path", Required: true, Description: "대상 학기 (예: 2027-1)", Schema: &openAPISchema{Type: "string"}},
+		},
+		Body:   dto.CloneTermRequest{},
+		Status: http.StatusCreated, Data: dto.CloneTermResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/rooms", Tag: "admin",
+		Summary: "강의실 등록", OperationID: "createRoom",
+		Body:   dto.CreateRoomRequest{},
+		Status: http.StatusCreated, Data: dto.RoomResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/rooms", Tag: "admin",
+		Summary: "강의실 목록 조회", OperationID: "listRooms",
+		Status: http.StatusOK, Data: []dto.RoomResponse{},
+	},
+	{
+		Method: http.MethodPatch, Path: "/api/v1/admin/rooms/:roomId", Tag: "admin",
+		Summary: "강의실 이름, 좌석 수 변경 (배정된 강좌 정원 이상)", OperationID: "updateRoom",
+		Parameters: []openAPIParameter{roomIDParameter},
+		Body:       dto.UpdateRoomRequest{},
+		Status:     http.StatusOK, Data: dto.RoomResponse{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/admin/rooms/:roomId", Tag: "admin",
+		Summary: "강의실 삭제 (강좌가 배정되어 있으면 거부)", OperationID: "deleteRoom",
+		Parameters: []openAPIParameter{roomIDParameter},
+		Status:     http.StatusOK, Data: map[string]string{},
+		Errors: []int{http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/lectures", Tag: "admin",
+		Summary: "강좌 등록 (학기를 생략하면 현재 학기)", OperationID: "createLecture",
+		Body:   dto.CreateLectureRequest{},
+		Status: http.StatusCreated, Data: map[string]string{},
+		Errors: []int{http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/lectures", Tag: "admin",
+		Summary: "전체 강좌 목록 조회", OperationID: "listLectures",
+		Parameters: []openAPIParameter{termParameter("학기, 생략하면 모든 학기")},
+		Status:     http.StatusOK, Data: []dto.LectureResponse{},
+		Errors: []int{http.StatusUnprocessableEntity},
+	},
+	{
+		Method: http.MethodPatch, Path: "/api/v1/admin/lectures/:id", Tag: "admin",
+		Summary: "강좌 정보 변경 (보낸 항목만, 수강생 시간 충돌 검사)", OperationID: "updateLecture",
+		Body:   dto.UpdateLectureRequest{},
+		Status: http.StatusOK, Data: dto.LectureResponse{},
+		Errors: []int{
+			http.StatusBadRequest, http.StatusNotFound, http.StatusConflict,
+			http.StatusUnprocessableEntity, http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+		},
+	},
+	{
+		Method: http.MethodDelete, Path: "/api/v1/admin/lectures/:id", Tag: "admin",
+		Summary: "강좌 삭제 (수강신청 연쇄 삭제)", OperationID: "deleteLecture",
+		Status: http.StatusOK, Data: map[string]string{},
+		Errors: []int{http.StatusUnprocessableEntity, http.StatusNotFound},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/locks/stats", Tag: "admin",
+		Summary: "잠금 키별 경합 통계 조회", OperationID: "getLockStats",
+		Status: http.StatusOK, Data: []lock.Stat{},
+	},
+	{
+		Method: http.MethodPost, Path: "/api/v1/admin/maintenance/reconcile", Tag: "admin",
+		Summary: "강좌별 수강 인원 점검 및 보정", OperationID: "reconcile",
+		Parameters: []openAPIParameter{
+			{Name: "repair", In: "query", Description: "true이면 실제 수강신청 수로 보정", Schema: &openAPISchema{Type: "boolean"}},
+		},
+		Status: http.StatusOK, Data: dto.ReconcileResponse{},
+		Errors: []int{http.StatusBadRequest},
+	},
+	{
+		Method: http.MethodGet, Path: "/api/v1/admin/cache/stats", Tag: "admin",
+		Summary: "강좌 캐시 적중/실패 통계 조회", OperationID: "getCacheStats",
+		Status: http.StatusOK, Data: repository.CacheStats{},
+	},
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"golang-course-registration/config"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newAPIRoutesForTest 서버와 같은 경로에 컨트롤러 라우트만 등록 (핸들러는 호출하지 않으므로 서비스 없이 생성)
+func newAPIRoutesForTest() *echo.Echo {
+	e := echo.New()
+	NewOpenAPIController().RegisterRoutes(e.Group("/api"))
+
+	v1 := e.Group("/api/v1")
+	NewHealthController(config.StorageMemory, nil).RegisterRoutes(v1)
+	NewClientController(nil, nil, nil, config.OperationTimeouts{}).RegisterRoutes(v1.Group("/client"))
+	NewAdminController(nil, nil, nil, nil, nil, config.OperationTimeouts{}).RegisterRoutes(v1.Group("/admin"))
+	return e
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	document := buildOpenAPIDocument()
+
+	t.Run("등록된 모든 API 라우트가 명세에 존재", func(t *testing.T) {
+		for _, route := range newAPIRoutesForTest().Routes() {
+			if !strings.HasPrefix(route.Path, "/api/v1/") {
+				continue
+			}
+			if _, ok := document.Paths[openAPIPath(route.Path)][strings.ToLower(route.Method)]; !ok {
+				t.Errorf("기대 : %s %s 명세 존재, 결과 : 없음", route.Method, route.Path)
+			}
+		}
+	})
+
+	t.Run("명세의 모든 경로가 라우트로 등록됨", func(t *testing.T) {
+		// given
+		var registered []string
+		for _, route := range newAPIRoutesForTest().Routes() {
+			registered = append(registered, route.Method+" "+openAPIPath(route.Path))
+		}
+
+		// then
+		for path, operations := range document.Paths {
+			for method := range operations {
+				if !slices.Contains(registered, strings.ToUpper(method)+" "+path) {
+					t.Errorf("기대 : %s %s 라우트 존재, 결과 : 없음", strings.ToUpper(method), path)
+				}
+			}
+		}
+	})
+
+	t.Run("DTO의 json 필드 이름을 스키마에 반영", func(t *testing.T) {
+		testCases := []struct {
+			schema   string
+			property string
+		}{
+			{"CreateLectureRequest", "slots"},
+			{"MeetingSlotRequest", "start_time"},
+			{"EnrollRequest", "student_id"},
+			{"LectureSearchResponse", "score"},
+			{"LectureSearchResponse", "current_enrollment"},
+			{"EnrollmentResponse", "dropped_at"},
+		}
+
+		for _, tc := range testCases {
+			schema, ok := document.Components.Schemas[tc.schema]
+			if !ok || schema.Properties[tc.property] == nil {
+				t.Errorf("기대 : %s.%s, 결과 : 없음", tc.schema, tc.property)
+			}
+		}
+	})
+
+	t.Run("경로 파라미터와 쿼리 파라미터", func(t *testing.T) {
+		// given
+		cancel := document.Paths["/api/v1/client/enrollments/{studentId}/{lectureId}"][strings.ToLower(http.MethodDelete)]
+		list := document.Paths["/api/v1/client/lectures"][strings.ToLower(http.MethodGet)]
+
+		// then
+		var names []string
+		for _, param := range append(cancel.Parameters, list.Parameters...) {
+			names = append(names, param.In+":"+param.Name)
+		}
+		for _, expected := range []string{"path:studentId", "path:lectureId", "query:day", "query:open_only", "query:page"} {
+			if !slices.Contains(names, expected) {
+				t.Errorf("기대 : %s, 결과 : %v", expected, names)
+			}
+		}
+	})
+}
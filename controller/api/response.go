@@ -1,24 +1,90 @@
 package api
 
+import (
+	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
+
+	"github.com/labstack/echo/v4"
+)
+
 type response struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
+	Meta    *pageMeta   `json:"meta,omitempty"`
 	Error   *apiError   `json:"error,omitempty"`
 }
 
 type apiError struct {
+	Code      string               `json:"code"`
+	Message   string               `json:"message"`
+	Details   []apiFieldError      `json:"details,omitempty"`
+	Conflicts []apiStudentConflict `json:"conflicts,omitempty"`
+}
+
+// apiFieldError 요청 검증에 실패한 필드 (field는 요청 본문의 json 필드 이름)
+type apiFieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// apiStudentConflict 강좌 시간을 변경하면 시간이 겹치게 되는 수강생과 겹치는 강좌
+type apiStudentConflict struct {
+	StudentID   int    `json:"student_id"`
+	LectureID   int    `json:"lecture_id"`
+	LectureName string `json:"lecture_name"`
+}
+
+// pageMeta 목록 응답의 페이지 정보
+type pageMeta struct {
+	Page       int  `json:"page"`
+	Size       int  `json:"size"`
+	TotalCount int  `json:"total_count"`
+	TotalPages int  `json:"total_pages"`
+	HasNext    bool `json:"has_next"`
+}
+
 func successResponse(data interface{}) response {
 	return response{Success: true, Data: data}
 }
 
-func errorResponse(message string) response {
+func pagedResponse(data interface{}, page, size, totalCount, totalPages int) response {
 	return response{
-		Success: false,
-		Error: &apiError{
-			Message: message,
+		Success: true,
+		Data:    data,
+		Meta: &pageMeta{
+			Page:       page,
+			Size:       size,
+			TotalCount: totalCount,
+			TotalPages: totalPages,
+			HasNext:    page < totalPages,
 		},
 	}
 }
+
+func errorResponse(err *exception.Error, locale i18n.Locale) response {
+	body := &apiError{
+		Code:    err.Code,
+		Message: err.Localize(locale),
+	}
+	for _, fieldErr := range err.Details {
+		body.Details = append(body.Details, apiFieldError{
+			Field:   fieldErr.Field,
+			Code:    fieldErr.Err.Code,
+			Message: fieldErr.Err.Localize(locale),
+		})
+	}
+	for _, conflict := range err.Conflicts {
+		body.Conflicts = append(body.Conflicts, apiStudentConflict{
+			StudentID:   conflict.StudentID,
+			LectureID:   conflict.LectureID,
+			LectureName: conflict.LectureName,
+		})
+	}
+	return response{Success: false, Error: body}
+}
+
+// requestLocale 요청에서 협상된 언어 (Accept-Language, lang 쿼리/쿠키)
+func requestLocale(ctx echo.Context) i18n.Locale {
+	return i18n.FromContext(ctx.Request().Context())
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// withTimeout 요청 컨텍스트에 처리 제한 시간을 설정하는 라우트 미들웨어 (0 이하면 제한 없음)
+// 핸들러는 ctx.Request().Context()를 서비스에 넘겨 잠금 대기와 저장소 조회까지 마감을 전달
+func withTimeout(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if timeout <= 0 {
+				return next(ctx)
+			}
+
+			timeoutCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+			defer cancel()
+			ctx.SetRequest(ctx.Request().WithContext(timeoutCtx))
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"golang-course-registration/common/exception"
+
+	"github.com/labstack/echo/v4"
+)
+
+// validatable 필드 검증을 제공하는 요청 DTO
+type validatable interface {
+	Validate() error
+}
+
+// bindRequest 요청 본문을 바인딩한 뒤 모든 필드를 검증
+// 타입이 맞지 않는 필드(예: capacity에 문자열)도 나머지 필드의 검증 결과와 함께 필드별 상세로 반환
+func bindRequest(ctx echo.Context, req validatable) error {
+	var fieldErrs exception.FieldErrors
+	if err := ctx.Bind(req); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if !errors.As(err, &typeErr) || typeErr.Field == "" {
+			return exception.ErrInvalidRequestBody
+		}
+		fieldErrs.Add(typeErr.Field, exception.ErrFieldInvalid)
+	}
+
+	validateErr := req.Validate()
+	var validationErr *exception.Error
+	if !errors.As(validateErr, &validationErr) || len(validationErr.Details) == 0 {
+		// 필드 하나에 속하지 않는 검증 실패(예: 변경할 항목 없음)는 타입 오류가 없을 때 그대로 반환
+		if validateErr != nil && len(fieldErrs) == 0 {
+			return validateErr
+		}
+		return fieldErrs.Err()
+	}
+
+	for _, fieldErr := range validationErr.Details {
+		if !fieldErrs.Has(fieldErr.Field) {
+			fieldErrs = append(fieldErrs, fieldErr)
+		}
+	}
+	return fieldErrs.Err()
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"golang-course-registration/controller/dto"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestBindRequest(t *testing.T) {
+	respond := func(body string) (int, *apiError) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+
+		var lecture dto.CreateLectureRequest
+		if err := bindRequest(ctx, &lecture); err != nil {
+			_ = respondError(ctx, err)
+		}
+
+		var res response
+		_ = json.Unmarshal(rec.Body.Bytes(), &res)
+		return rec.Code, res.Error
+	}
+
+	fieldsOf := func(apiErr *apiError) []string {
+		var fields []string
+		for _, detail := range apiErr.Details {
+			fields = append(fields, detail.Field)
+		}
+		return fields
+	}
+
+	t.Run("성공 : 올바른 요청", func(t *testing.T) {
+		// when
+		status, apiErr := respond(`{"id":1001,"name":"데이터베이스","capacity":30,"credit":3,"slots":[{"day":"MON","start_time":"09:00","end_time":"10:30"}]}`)
+
+		// then
+		if apiErr != nil {
+			t.Errorf("기대 : 검증 통과, 결과 : %d %+v", status, apiErr)
+		}
+	})
+
+	t.Run("예외 : 모든 필드 검증 실패를 한 번에 반환", func(t *testing.T) {
+		// when
+		status, apiErr := respond(`{"name":"A","capacity":50,"slots":[{"day":"MON","start_time":"09:00","end_time":"10:30"},{"day":"토요일","start_time":"25:00"}]}`)
+
+		// then
+		expected := []string{"id", "name", "capacity", "credit", "slots[1].day", "slots[1].start_time", "slots[1].end_time"}
+		if status != http.StatusUnprocessableEntity || apiErr == nil || !slices.Equal(fieldsOf(apiErr), expected) {
+			t.Errorf("기대 : 422 %v, 결과 : %d %+v", expected, status, apiErr)
+		}
+	})
+
+	t.Run("예외 : 타입이 맞지 않는 필드도 다른 필드와 함께 반환", func(t *testing.T) {
+		// when
+		status, apiErr := respond(`{"id":1001,"name":"데이터베이스","capacity":"많이","credit":9,"slots":[{"day":"MON","start_time":"09:00","end_time":"10:30"}]}`)
+
+		// then
+		expected := []string{"capacity", "credit"}
+		if status != http.StatusUnprocessableEntity || apiErr == nil || !slices.Equal(fieldsOf(apiErr), expected) {
+			t.Errorf("기대 : 422 %v, 결과 : %d %+v", expected, status, apiErr)
+		}
+	})
+
+	t.Run("예외 : JSON 형식 오류", func(t *testing.T) {
+		// when
+		status, apiErr := respond(`{"id":`)
+
+		// then
+		if status != http.StatusBadRequest || apiErr == nil || apiErr.Code != "INVALID_REQUEST_BODY" {
+			t.Errorf("기대 : 400 INVALID_REQUEST_BODY, 결과 : %d %+v", status, apiErr)
+		}
+	})
+}
@@ -1,7 +1,10 @@
 package dto
 
 import (
+	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
+	"strings"
+	"time"
 )
 
 type EnrollRequest struct {
@@ -9,16 +12,60 @@ type EnrollRequest struct {
 	LectureID int `json:"lecture_id"`
 }
 
+// Validate 학번과 강좌번호 범위 검사
+func (r EnrollRequest) Validate() error {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("student_id", model.ValidateStudentID(r.StudentID))
+	fieldErrs.Add("lecture_id", model.ValidateLectureID(r.LectureID))
+	return fieldErrs.Err()
+}
+
+// EnrollmentHistoryRequest 수강신청 이력 조회 조건 (쿼리 파라미터)
+type EnrollmentHistoryRequest struct {
+	Status string `query:"status"` // 쉼표로 구분한 상태 목록, 비어 있으면 모든 상태
+	Term   string `query:"term"`   // 비어 있으면 현재 학기
+}
+
+// Statuses 조회할 상태 목록, 허용되지 않는 상태가 있으면 ErrEnrollmentStatusInvalid
+func (r EnrollmentHistoryRequest) Statuses() ([]model.EnrollmentStatus, error) {
+	if r.Status == "" {
+		return nil, nil
+	}
+
+	var statuses []model.EnrollmentStatus
+	for _, value := range strings.Split(r.Status, ",") {
+		status, err := model.ParseEnrollmentStatus(strings.ToUpper(strings.TrimSpace(value)))
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// EnrollmentResponse 수강신청과 상태별 변경 시각 (거치지 않은 상태의 시각은 생략)
 type EnrollmentResponse struct {
-	ID        int `json:"id"`
-	StudentID int `json:"student_id"`
-	LectureID int `json:"lecture_id"`
+	ID           int                    `json:"id"`
+	StudentID    int                    `json:"student_id"`
+	LectureID    int                    `json:"lecture_id"`
+	TermID       string                 `json:"term_id"`
+	Status       model.EnrollmentStatus `json:"status"`
+	WaitlistedAt time.Time              `json:"waitlisted_at,omitzero"`
+	EnrolledAt   time.Time              `json:"enrolled_at,omitzero"`
+	DroppedAt    time.Time              `json:"dropped_at,omitzero"`
+	WithdrawnAt  time.Time              `json:"withdrawn_at,omitzero"`
 }
 
 func NewEnrollmentResponse(enrollment model.Enrollment) EnrollmentResponse {
 	return EnrollmentResponse{
-		ID:        enrollment.ID,
-		StudentID: enrollment.StudentID,
-		LectureID: enrollment.LectureID,
+		ID:           enrollment.ID,
+		StudentID:    enrollment.StudentID,
+		LectureID:    enrollment.LectureID,
+		TermID:       enrollment.TermID,
+		Status:       enrollment.Status,
+		WaitlistedAt: enrollment.WaitlistedAt,
+		EnrolledAt:   enrollment.EnrolledAt,
+		DroppedAt:    enrollment.DroppedAt,
+		WithdrawnAt:  enrollment.WithdrawnAt,
 	}
 }
@@ -0,0 +1,15 @@
+package dto
+
+import "golang-course-registration/infrastructure/resilience"
+
+// 서버 상태
+const (
+	HealthOK       = "ok"
+	HealthDegraded = "degraded"
+)
+
+type HealthResponse struct {
+	Status  string                   `json:"status"`
+	Storage string                   `json:"storage"`
+	Breaker *resilience.BreakerStats `json:"breaker,omitempty"`
+}
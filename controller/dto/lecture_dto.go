@@ -1,39 +1,157 @@
 package dto
 
 import (
+	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
 	"golang-course-registration/model"
 )
 
+// CreateLectureRequest 학기를 생략하면 현재 학기에 등록, 강의실은 생략하면 미배정
 type CreateLectureRequest struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Capacity  int       `json:"capacity"`
-	Credit    int       `json:"credit"`
+	TermID   string               `json:"term_id,omitempty"`
+	ID       int                  `json:"id"`
+	Name     string               `json:"name"`
+	Capacity int                  `json:"capacity"`
+	Credit   int                  `json:"credit"`
+	Slots    []MeetingSlotRequest `json:"slots"`
+	RoomID   string               `json:"room_id,omitempty"`
+}
+
+// MeetingSlotRequest 강좌의 수업 시간 하나, 요일은 MON 외에 월요일/Monday/1(ISO 요일 번호)도 허용
+type MeetingSlotRequest struct {
 	Day       model.Day `json:"day"`
 	StartTime string    `json:"start_time"`
 	EndTime   string    `json:"end_time"`
 }
 
+// Validate 모든 필드를 검사하여 실패한 필드를 한 번에 반환
+func (r CreateLectureRequest) Validate() error {
+	return model.ValidateLecture(r.ID, r.Name, r.Capacity, r.Credit, ToMeetingSlotInputs(r.Slots))
+}
+
+// ToMeetingSlotInputs 요청의 수업 시간 목록을 모델 입력으로 변환 (검증은 모델에서)
+func ToMeetingSlotInputs(requests []MeetingSlotRequest) []model.MeetingSlotInput {
+	inputs := make([]model.MeetingSlotInput, 0, len(requests))
+	for _, req := range requests {
+		inputs = append(inputs, model.MeetingSlotInput{Day: req.Day, StartTime: req.StartTime, EndTime: req.EndTime})
+	}
+	return inputs
+}
+
+// UpdateLectureRequest 강좌 정보 변경, 보낸 항목만 변경 (강좌번호와 현재 수강 인원은 변경 불가)
+// 수업 시간은 보내면 목록 전체를 교체 (생략하거나 null이면 유지), 강의실은 빈 문자열을 보내면 배정 해제
+type UpdateLectureRequest struct {
+	Name         *string              `json:"name,omitempty"`
+	Capacity     *int                 `json:"capacity,omitempty"`
+	Credit       *int                 `json:"credit,omitempty"`
+	Slots        []MeetingSlotRequest `json:"slots,omitempty"`
+	RoomID       *string              `json:"room_id,omitempty"`
+	Discontinued *bool                `json:"discontinued,omitempty"`
+}
+
+// Validate 변경할 항목이 하나도 없으면 거부, 각 항목은 기존 강좌와 합친 뒤 서비스에서 검사
+func (r UpdateLectureRequest) Validate() error {
+	if r.Name == nil && r.Capacity == nil && r.Credit == nil && r.Slots == nil && r.RoomID == nil && r.Discontinued == nil {
+		return exception.ErrLectureUpdateEmpty
+	}
+	return nil
+}
+
+// Apply 보낸 항목을 lecture에 덮어쓴 강좌명, 정원, 학점, 수업 시간 목록
+func (r UpdateLectureRequest) Apply(lecture model.Lecture) (name string, capacity, credit int, slots []model.MeetingSlotInput) {
+	slots = lecture.SlotInputs()
+	if r.Slots != nil {
+		slots = ToMeetingSlotInputs(r.Slots)
+	}
+	return valueOr(r.Name, lecture.Name),
+		valueOr(r.Capacity, lecture.Capacity),
+		valueOr(r.Credit, lecture.Credit),
+		slots
+}
+
+// ApplyDiscontinued 보낸 폐강 예정 표시, 보내지 않았으면 lecture의 값
+func (r UpdateLectureRequest) ApplyDiscontinued(lecture model.Lecture) bool {
+	return valueOr(r.Discontinued, lecture.Discontinued)
+}
+
+// ApplyRoom 보낸 강의실, 보내지 않았으면 lecture의 강의실 (빈 문자열은 배정 해제)
+func (r UpdateLectureRequest) ApplyRoom(lecture model.Lecture) string {
+	return valueOr(r.RoomID, lecture.RoomID)
+}
+
+func valueOr[T any](value *T, fallback T) T {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
+
+// LectureListRequest 강좌 목록 필터, 정렬, 페이지 조건 (쿼리 파라미터)
+type LectureListRequest struct {
+	Term      string    `query:"term"` // 비어 있으면 현재 학기
+	Day       model.Day `query:"day"`
+	Credit    int       `query:"credit"`
+	OpenOnly  bool      `query:"open_only"`
+	StartFrom string    `query:"start_from"`
+	EndUntil  string    `query:"end_until"`
+	Name      string    `query:"name"`
+	Sort      string    `query:"sort"`
+	Order     string    `query:"order"`
+	Page      int       `query:"page"`
+	Size      int       `query:"size"`
+}
+
+type LecturePageResponse struct {
+	Lectures   []LectureResponse
+	Page       int
+	Size       int
+	TotalCount int
+	TotalPages int
+}
+
+type LectureSearchResponse struct {
+	LectureResponse
+	Score int `json:"score"`
+}
+
 type LectureResponse struct {
-	ID                int    `json:"id"`
-	Name              string `json:"name"`
-	Capacity          int    `json:"capacity"`
-	CurrentEnrollment int    `json:"current_enrollment,omitempty"`
-	Credit            int    `json:"credit"`
-	Day               string `json:"day"`
-	StartTime         string `json:"start_time"`
-	EndTime           string `json:"end_time"`
+	ID                int                   `json:"id"`
+	TermID            string                `json:"term_id"`
+	Name              string                `json:"name"`
+	Capacity          int                   `json:"capacity"`
+	CurrentEnrollment int                   `json:"current_enrollment,omitempty"`
+	Credit            int                   `json:"credit"`
+	Slots             []MeetingSlotResponse `json:"slots"`
+	RoomID            string                `json:"room_id,omitempty"`
+	Discontinued      bool                  `json:"discontinued,omitempty"`
 }
 
-func NewLectureResponse(lecture model.Lecture) LectureResponse {
+type MeetingSlotResponse struct {
+	Day       string `json:"day"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+// NewLectureResponse 요일은 locale 언어의 이름으로 변환
+func NewLectureResponse(lecture model.Lecture, locale i18n.Locale) LectureResponse {
+	slots := make([]MeetingSlotResponse, 0, len(lecture.Slots))
+	for _, slot := range lecture.Slots {
+		slots = append(slots, MeetingSlotResponse{
+			Day:       slot.Day.Name(locale),
+			StartTime: slot.StartTime.String(),
+			EndTime:   slot.EndTime.String(),
+		})
+	}
+
 	return LectureResponse{
 		ID:                lecture.ID,
+		TermID:            lecture.TermID,
 		Name:              lecture.Name,
 		Capacity:          lecture.Capacity,
 		CurrentEnrollment: lecture.CurrentEnrollment,
 		Credit:            lecture.Credit,
-		Day:               lecture.Day.ToKorean(),
-		StartTime:         lecture.StartTime,
-		EndTime:           lecture.EndTime,
+		Slots:             slots,
+		RoomID:            lecture.RoomID,
+		Discontinued:      lecture.Discontinued,
 	}
 }
@@ -0,0 +1,15 @@
+package dto
+
+type LectureDriftResponse struct {
+	LectureID         int    `json:"lecture_id"`
+	Name              string `json:"name"`
+	CurrentEnrollment int    `json:"current_enrollment"`
+	ActualEnrollment  int    `json:"actual_enrollment"`
+	Repaired          bool   `json:"repaired"`
+}
+
+type ReconcileResponse struct {
+	Checked int                    `json:"checked"`
+	Repair  bool                   `json:"repair"`
+	Drifts  []LectureDriftResponse `json:"drifts"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+)
+
+type CreateRoomRequest struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Seats int    `json:"seats"`
+}
+
+// Validate 모든 필드를 검사하여 실패한 필드를 한 번에 반환
+func (r CreateRoomRequest) Validate() error {
+	_, err := model.NewRoom(r.ID, r.Name, r.Seats)
+	return err
+}
+
+// UpdateRoomRequest 강의실 정보 변경, 보낸 항목만 변경 (강의실 번호는 변경 불가)
+type UpdateRoomRequest struct {
+	Name  *string `json:"name,omitempty"`
+	Seats *int    `json:"seats,omitempty"`
+}
+
+// Validate 변경할 항목이 하나도 없으면 거부, 각 항목은 기존 강의실과 합친 뒤 서비스에서 검사
+func (r UpdateRoomRequest) Validate() error {
+	if r.Name == nil && r.Seats == nil {
+		return exception.ErrRoomUpdateEmpty
+	}
+	return nil
+}
+
+// Apply 보낸 항목을 room에 덮어쓴 이름, 좌석 수
+func (r UpdateRoomRequest) Apply(room model.Room) (name string, seats int) {
+	return valueOr(r.Name, room.Name), valueOr(r.Seats, room.Seats)
+}
+
+type RoomResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Seats int    `json:"seats"`
+}
+
+func NewRoomResponse(room model.Room) RoomResponse {
+	return RoomResponse{ID: room.ID, Name: room.Name, Seats: room.Seats}
+}
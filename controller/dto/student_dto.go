@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
 )
 
@@ -8,6 +9,13 @@ type CreateStudentRequest struct {
 	ID int `json:"id"`
 }
 
+// Validate 학번 범위 검사
+func (r CreateStudentRequest) Validate() error {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("id", model.ValidateStudentID(r.ID))
+	return fieldErrs.Err()
+}
+
 type StudentResponse struct {
 	ID int `json:"id"`
 }
@@ -0,0 +1,166 @@
+package dto
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
+	"golang-course-registration/model"
+	"math"
+)
+
+// CreateTermRequest 시작/종료일은 "YYYY-MM-DD" 형식
+type CreateTermRequest struct {
+	ID        string `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// Validate 학기 형식과 시작/종료일 검사
+func (r CreateTermRequest) Validate() error {
+	_, err := model.NewTerm(r.ID, r.StartDate, r.EndDate)
+	return err
+}
+
+// TermResponse Active는 현재 수강신청을 받는 학기인지 여부
+type TermResponse struct {
+	ID        string `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Active    bool   `json:"active"`
+}
+
+func NewTermResponse(term model.Term, activeTerm string) TermResponse {
+	return TermResponse{
+		ID:        term.ID,
+		StartDate: term.StartDate.Format(model.TermDateLayout),
+		EndDate:   term.EndDate.Format(model.TermDateLayout),
+		Active:    term.ID == activeTerm,
+	}
+}
+
+// CloneTermRequest 원본 학기(source_term)의 강좌를 대상 학기(경로의 termId)로 복사
+// 강좌번호는 id_map에 있으면 그 번호, 없으면 원래 번호 + id_offset
+// 정원은 capacity_scale(생략하면 1)을 곱해 반올림하고 최소 1명, dry_run이면 저장하지 않고 계획만 반환
+type CloneTermRequest struct {
+	TargetTerm    string      `param:"termId" json:"-"`
+	SourceTerm    string      `json:"source_term"`
+	IDOffset      int         `json:"id_offset,omitempty"`
+	IDMap         map[int]int `json:"id_map,omitempty"`
+	CapacityScale float64     `json:"capacity_scale,omitempty"`
+	DryRun        bool        `json:"dry_run"`
+}
+
+// Validate 학기 형식, 원본과 대상 학기가 다른지, 정원 배율 검사 (복사할 강좌는 등록과 같은 규칙으로 서비스에서 검사)
+func (r CloneTermRequest) Validate() error {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("term_id", model.ValidateTermID(r.TargetTerm))
+	fieldErrs.Add("source_term", model.ValidateTermID(r.SourceTerm))
+	if !fieldErrs.Has("source_term") && r.SourceTerm == r.TargetTerm {
+		fieldErrs.Add("source_term", exception.ErrTermCloneSameTerm)
+	}
+	if r.CapacityScale < 0 || math.IsNaN(r.CapacityScale) {
+		fieldErrs.Add("capacity_scale", exception.ErrTermCloneScaleInvalid)
+	}
+	return fieldErrs.Err()
+}
+
+// LectureRequest 원본 강좌를 대상 학기에 등록할 요청으로 변환 (강좌번호 변환, 정원 배율 적용, 강의실 유지)
+func (r CloneTermRequest) LectureRequest(lecture model.Lecture) CreateLectureRequest {
+	id, ok := r.IDMap[lecture.ID]
+	if !ok {
+		id = lecture.ID + r.IDOffset
+	}
+
+	capacity := lecture.Capacity
+	if r.CapacityScale != 0 {
+		capacity = max(int(math.Round(float64(lecture.Capacity)*r.CapacityScale)), 1)
+	}
+
+	slots := make([]MeetingSlotRequest, 0, len(lecture.Slots))
+	for _, input := range lecture.SlotInputs() {
+		slots = append(slots, MeetingSlotRequest{Day: input.Day, StartTime: input.StartTime, EndTime: input.EndTime})
+	}
+
+	return CreateLectureRequest{
+		TermID:   r.TargetTerm,
+		ID:       id,
+		Name:     lecture.Name,
+		Capacity: capacity,
+		Credit:   lecture.Credit,
+		Slots:    slots,
+		RoomID:   lecture.RoomID,
+	}
+}
+
+// 복사 계획에서 강좌별 처리
+const (
+	CloneActionCreate = "create" // 등록 (dry_run이면 등록 예정)
+	CloneActionSkip   = "skip"   // 폐강 예정이라 제외
+	CloneActionError  = "error"  // 등록 검증 실패로 제외
+)
+
+// CloneTermResponse 강좌별 복사 계획(dry_run) 또는 결과와 처리별 개수
+type CloneTermResponse struct {
+	SourceTerm string                 `json:"source_term"`
+	TargetTerm string                 `json:"target_term"`
+	DryRun     bool                   `json:"dry_run"`
+	Created    int                    `json:"created"`
+	Skipped    int                    `json:"skipped"`
+	Failed     int                    `json:"failed"`
+	Lectures   []CloneLectureResponse `json:"lectures"`
+}
+
+// Add 강좌 하나의 처리 결과를 추가하고 개수를 갱신
+func (r *CloneTermResponse) Add(lecture CloneLectureResponse) {
+	switch lecture.Action {
+	case CloneActionCreate:
+		r.Created++
+	case CloneActionSkip:
+		r.Skipped++
+	case CloneActionError:
+		r.Failed++
+	}
+	r.Lectures = append(r.Lectures, lecture)
+}
+
+// CloneLectureResponse 원본 강좌와 대상 학기에 등록할 강좌의 차이 (강좌번호, 정원)
+type CloneLectureResponse struct {
+	SourceID       int                 `json:"source_id"`
+	TargetID       int                 `json:"target_id,omitempty"`
+	Name           string              `json:"name"`
+	SourceCapacity int                 `json:"source_capacity"`
+	TargetCapacity int                 `json:"target_capacity,omitempty"`
+	Action         string              `json:"action"`
+	Error          *CloneErrorResponse `json:"error,omitempty"`
+}
+
+// CloneErrorResponse 강좌를 등록하지 못한 이유, 필드별 검증 실패는 Details에 포함
+type CloneErrorResponse struct {
+	Code    string                    `json:"code"`
+	Message string                    `json:"message"`
+	Details []CloneFieldErrorResponse `json:"details,omitempty"`
+}
+
+type CloneFieldErrorResponse struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewCloneErrorResponse 도메인 에러를 locale 언어의 메시지로 변환, 도메인 에러가 아니면 false
+func NewCloneErrorResponse(err error, locale i18n.Locale) (*CloneErrorResponse, bool) {
+	var domainErr *exception.Error
+	if !errors.As(err, &domainErr) {
+		return nil, false
+	}
+
+	response := &CloneErrorResponse{Code: domainErr.Code, Message: domainErr.Localize(locale)}
+	for _, fieldErr := range domainErr.Details {
+		response.Details = append(response.Details, CloneFieldErrorResponse{
+			Field:   fieldErr.Field,
+			Code:    fieldErr.Err.Code,
+			Message: fieldErr.Err.Localize(locale),
+		})
+	}
+	return response, true
+}
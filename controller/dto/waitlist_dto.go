@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+)
+
+type WaitlistRequest struct {
+	StudentID int `json:"student_id"`
+	LectureID int `json:"lecture_id"`
+}
+
+// Validate 학번과 강좌번호 범위 검사
+func (r WaitlistRequest) Validate() error {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("student_id", model.ValidateStudentID(r.StudentID))
+	fieldErrs.Add("lecture_id", model.ValidateLectureID(r.LectureID))
+	return fieldErrs.Err()
+}
+
+// WaitlistResponse 대기 순번 (1부터)과 강좌의 전체 대기 인원
+type WaitlistResponse struct {
+	StudentID int `json:"student_id"`
+	LectureID int `json:"lecture_id"`
+	Position  int `json:"position"`
+	Waiting   int `json:"waiting"`
+}
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS lectures (
+  id bigint NOT NULL,
+  name character varying NOT NULL,
+  capacity bigint NOT NULL,
+  day character varying NOT NULL,
+  start_time character varying NOT NULL,
+  end_time character varying NOT NULL,
+  current_enrollment bigint NOT NULL DEFAULT 0,
+  credit bigint NOT NULL,
+  CONSTRAINT lectures_pkey PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS students (
+  id bigint NOT NULL,
+  CONSTRAINT students_pkey PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS enrollments (
+  id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+  student_id bigint NOT NULL,
+  lecture_id bigint NOT NULL,
+  CONSTRAINT enrollments_pkey PRIMARY KEY (id),
+  CONSTRAINT enrollments_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+  CONSTRAINT enrollments_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS enrollments_student_id_idx ON enrollments(student_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_idx ON enrollments(lecture_id);
@@ -0,0 +1 @@
+ALTER TABLE lectures DROP COLUMN IF EXISTS version;
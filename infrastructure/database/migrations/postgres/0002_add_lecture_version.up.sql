@@ -0,0 +1 @@
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS waitlist (
+  id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+  student_id bigint NOT NULL,
+  lecture_id bigint NOT NULL,
+  CONSTRAINT waitlist_pkey PRIMARY KEY (id),
+  CONSTRAINT waitlist_student_lecture_key UNIQUE (student_id, lecture_id),
+  CONSTRAINT waitlist_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+  CONSTRAINT waitlist_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS waitlist_lecture_id_idx ON waitlist(lecture_id);
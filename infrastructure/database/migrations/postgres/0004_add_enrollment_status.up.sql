@@ -0,0 +1,13 @@
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS status character varying NOT NULL DEFAULT 'ENROLLED';
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS waitlisted_at timestamp with time zone;
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS enrolled_at timestamp with time zone;
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS dropped_at timestamp with time zone;
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS withdrawn_at timestamp with time zone;
+
+-- 수강 대기는 대기 상태의 수강신청으로 옮김 (등록 순서 유지)
+INSERT INTO enrollments (student_id, lecture_id, status)
+SELECT student_id, lecture_id, 'WAITLISTED' FROM waitlist ORDER BY id;
+
+DROP TABLE IF EXISTS waitlist;
+
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_status_idx ON enrollments(lecture_id, status);
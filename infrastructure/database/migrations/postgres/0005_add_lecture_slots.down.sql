@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS lectures_slots_idx;
+
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS day character varying NOT NULL DEFAULT '';
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS start_time character varying NOT NULL DEFAULT '';
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS end_time character varying NOT NULL DEFAULT '';
+
+-- 첫 번째 수업 시간만 남김
+UPDATE lectures
+SET day        = COALESCE(slots->0->>'day', ''),
+    start_time = COALESCE(slots->0->>'start_time', ''),
+    end_time   = COALESCE(slots->0->>'end_time', '');
+
+ALTER TABLE lectures DROP COLUMN IF EXISTS slots;
@@ -0,0 +1,11 @@
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS slots jsonb NOT NULL DEFAULT '[]'::jsonb;
+
+-- 기존 요일/시작/종료 시간은 수업 시간 하나로 옮김
+UPDATE lectures
+SET slots = jsonb_build_array(jsonb_build_object('day', day, 'start_time', start_time, 'end_time', end_time));
+
+ALTER TABLE lectures DROP COLUMN IF EXISTS day;
+ALTER TABLE lectures DROP COLUMN IF EXISTS start_time;
+ALTER TABLE lectures DROP COLUMN IF EXISTS end_time;
+
+CREATE INDEX IF NOT EXISTS lectures_slots_idx ON lectures USING gin (slots jsonb_path_ops);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS enrollments_student_id_term_id_idx;
+ALTER TABLE enrollments DROP COLUMN IF EXISTS term_id;
+
+DROP INDEX IF EXISTS lectures_term_id_name_key;
+ALTER TABLE lectures DROP COLUMN IF EXISTS term_id;
+
+DROP TABLE IF EXISTS terms;
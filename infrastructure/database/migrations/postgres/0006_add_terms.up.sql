@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS terms (
+  id character varying NOT NULL,
+  start_date date NOT NULL,
+  end_date date NOT NULL,
+  CONSTRAINT terms_pkey PRIMARY KEY (id),
+  CONSTRAINT terms_date_order_check CHECK (start_date < end_date)
+);
+
+-- 기존 강좌와 수강신청은 모두 2026-2 학기로 옮김
+INSERT INTO terms (id, start_date, end_date) VALUES ('2026-2', '2026-09-01', '2027-02-28')
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS term_id character varying NOT NULL DEFAULT '2026-2'
+  CONSTRAINT lectures_term_id_fkey REFERENCES terms(id);
+ALTER TABLE lectures ALTER COLUMN term_id DROP DEFAULT;
+
+-- 강좌명은 같은 학기 안에서만 고유
+CREATE UNIQUE INDEX IF NOT EXISTS lectures_term_id_name_key ON lectures(term_id, name);
+
+ALTER TABLE enrollments ADD COLUMN IF NOT EXISTS term_id character varying
+  CONSTRAINT enrollments_term_id_fkey REFERENCES terms(id);
+UPDATE enrollments e SET term_id = l.term_id FROM lectures l WHERE l.id = e.lecture_id;
+ALTER TABLE enrollments ALTER COLUMN term_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS enrollments_student_id_term_id_idx ON enrollments(student_id, term_id);
@@ -0,0 +1 @@
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS discontinued boolean NOT NULL DEFAULT false;
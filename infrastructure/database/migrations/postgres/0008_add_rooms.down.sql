@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS lectures_term_id_room_id_idx;
+ALTER TABLE lectures DROP CONSTRAINT IF EXISTS lectures_room_id_fkey;
+ALTER TABLE lectures DROP COLUMN IF EXISTS room_id;
+DROP TABLE IF EXISTS rooms;
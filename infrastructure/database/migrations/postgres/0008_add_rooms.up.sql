@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS rooms (
+  id character varying NOT NULL,
+  name text NOT NULL,
+  seats integer NOT NULL,
+  CONSTRAINT rooms_pkey PRIMARY KEY (id),
+  CONSTRAINT rooms_seats_check CHECK (seats > 0)
+);
+
+-- 강의실이 배정되지 않은 강좌는 NULL, 강좌가 배정된 강의실은 삭제할 수 없음
+ALTER TABLE lectures ADD COLUMN IF NOT EXISTS room_id character varying
+  CONSTRAINT lectures_room_id_fkey REFERENCES rooms(id);
+
+CREATE INDEX IF NOT EXISTS lectures_term_id_room_id_idx ON lectures(term_id, room_id);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS lectures (
+	id                 INTEGER PRIMARY KEY,
+	name               TEXT    NOT NULL UNIQUE,
+	capacity           INTEGER NOT NULL,
+	day                TEXT    NOT NULL,
+	start_time         TEXT    NOT NULL,
+	end_time           TEXT    NOT NULL,
+	current_enrollment INTEGER NOT NULL DEFAULT 0,
+	credit             INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS students (
+	id INTEGER PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS enrollments (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	student_id INTEGER NOT NULL,
+	lecture_id INTEGER NOT NULL,
+	CONSTRAINT enrollments_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+	CONSTRAINT enrollments_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS enrollments_student_id_idx ON enrollments(student_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_idx ON enrollments(lecture_id);
@@ -0,0 +1 @@
+ALTER TABLE lectures ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS waitlist (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	student_id INTEGER NOT NULL,
+	lecture_id INTEGER NOT NULL,
+	CONSTRAINT waitlist_student_lecture_key UNIQUE (student_id, lecture_id),
+	CONSTRAINT waitlist_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+	CONSTRAINT waitlist_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS waitlist_lecture_id_idx ON waitlist(lecture_id);
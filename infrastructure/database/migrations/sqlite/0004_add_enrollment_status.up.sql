@@ -0,0 +1,13 @@
+ALTER TABLE enrollments ADD COLUMN status TEXT NOT NULL DEFAULT 'ENROLLED';
+ALTER TABLE enrollments ADD COLUMN waitlisted_at DATETIME;
+ALTER TABLE enrollments ADD COLUMN enrolled_at DATETIME;
+ALTER TABLE enrollments ADD COLUMN dropped_at DATETIME;
+ALTER TABLE enrollments ADD COLUMN withdrawn_at DATETIME;
+
+-- 수강 대기는 대기 상태의 수강신청으로 옮김 (등록 순서 유지)
+INSERT INTO enrollments (student_id, lecture_id, status)
+SELECT student_id, lecture_id, 'WAITLISTED' FROM waitlist ORDER BY id;
+
+DROP TABLE IF EXISTS waitlist;
+
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_status_idx ON enrollments(lecture_id, status);
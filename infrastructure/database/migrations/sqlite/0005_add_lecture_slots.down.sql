@@ -0,0 +1,11 @@
+ALTER TABLE lectures ADD COLUMN day TEXT NOT NULL DEFAULT '';
+ALTER TABLE lectures ADD COLUMN start_time TEXT NOT NULL DEFAULT '';
+ALTER TABLE lectures ADD COLUMN end_time TEXT NOT NULL DEFAULT '';
+
+-- 첫 번째 수업 시간만 남김
+UPDATE lectures
+SET day        = COALESCE(json_extract(slots, '$[0].day'), ''),
+    start_time = COALESCE(json_extract(slots, '$[0].start_time'), ''),
+    end_time   = COALESCE(json_extract(slots, '$[0].end_time'), '');
+
+ALTER TABLE lectures DROP COLUMN slots;
@@ -0,0 +1,9 @@
+ALTER TABLE lectures ADD COLUMN slots TEXT NOT NULL DEFAULT '[]';
+
+-- 기존 요일/시작/종료 시간은 수업 시간 하나로 옮김
+UPDATE lectures
+SET slots = json_array(json_object('day', day, 'start_time', start_time, 'end_time', end_time));
+
+ALTER TABLE lectures DROP COLUMN day;
+ALTER TABLE lectures DROP COLUMN start_time;
+ALTER TABLE lectures DROP COLUMN end_time;
@@ -0,0 +1,42 @@
+-- 강좌명이 다시 전체에서 고유해야 하므로 학기가 다른 같은 이름의 강좌가 있으면 실패
+ALTER TABLE enrollments RENAME TO enrollments_old;
+ALTER TABLE lectures RENAME TO lectures_old;
+
+CREATE TABLE lectures (
+	id                 INTEGER PRIMARY KEY,
+	name               TEXT    NOT NULL UNIQUE,
+	capacity           INTEGER NOT NULL,
+	current_enrollment INTEGER NOT NULL DEFAULT 0,
+	credit             INTEGER NOT NULL,
+	slots              TEXT    NOT NULL DEFAULT '[]',
+	version            INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO lectures (id, name, capacity, current_enrollment, credit, slots, version)
+SELECT id, name, capacity, current_enrollment, credit, slots, version FROM lectures_old;
+
+CREATE TABLE enrollments (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	student_id    INTEGER NOT NULL,
+	lecture_id    INTEGER NOT NULL,
+	status        TEXT    NOT NULL DEFAULT 'ENROLLED',
+	waitlisted_at DATETIME,
+	enrolled_at   DATETIME,
+	dropped_at    DATETIME,
+	withdrawn_at  DATETIME,
+	CONSTRAINT enrollments_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+	CONSTRAINT enrollments_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE
+);
+
+UPDATE sqlite_sequence SET name = 'enrollments' WHERE name = 'enrollments_old';
+
+INSERT INTO enrollments (id, student_id, lecture_id, status, waitlisted_at, enrolled_at, dropped_at, withdrawn_at)
+SELECT id, student_id, lecture_id, status, waitlisted_at, enrolled_at, dropped_at, withdrawn_at FROM enrollments_old;
+
+DROP TABLE enrollments_old;
+DROP TABLE lectures_old;
+DROP TABLE IF EXISTS terms;
+
+CREATE INDEX IF NOT EXISTS enrollments_student_id_idx ON enrollments(student_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_idx ON enrollments(lecture_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_status_idx ON enrollments(lecture_id, status);
@@ -0,0 +1,60 @@
+CREATE TABLE IF NOT EXISTS terms (
+	id         TEXT PRIMARY KEY,
+	start_date TEXT NOT NULL,
+	end_date   TEXT NOT NULL,
+	CHECK (start_date < end_date)
+);
+
+-- 기존 강좌와 수강신청은 모두 2026-2 학기로 옮김
+INSERT INTO terms (id, start_date, end_date) VALUES ('2026-2', '2026-09-01', '2027-02-28');
+
+-- 강좌명 UNIQUE 제약을 학기별로 바꾸기 위해 테이블을 다시 만듦
+ALTER TABLE enrollments RENAME TO enrollments_old;
+ALTER TABLE lectures RENAME TO lectures_old;
+
+CREATE TABLE lectures (
+	id                 INTEGER PRIMARY KEY,
+	term_id            TEXT    NOT NULL,
+	name               TEXT    NOT NULL,
+	capacity           INTEGER NOT NULL,
+	current_enrollment INTEGER NOT NULL DEFAULT 0,
+	credit             INTEGER NOT NULL,
+	slots              TEXT    NOT NULL DEFAULT '[]',
+	version            INTEGER NOT NULL DEFAULT 0,
+	CONSTRAINT lectures_term_id_name_key UNIQUE (term_id, name),
+	CONSTRAINT lectures_term_id_fkey FOREIGN KEY (term_id) REFERENCES terms(id)
+);
+
+INSERT INTO lectures (id, term_id, name, capacity, current_enrollment, credit, slots, version)
+SELECT id, '2026-2', name, capacity, current_enrollment, credit, slots, version FROM lectures_old;
+
+CREATE TABLE enrollments (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	student_id    INTEGER NOT NULL,
+	lecture_id    INTEGER NOT NULL,
+	term_id       TEXT    NOT NULL,
+	status        TEXT    NOT NULL DEFAULT 'ENROLLED',
+	waitlisted_at DATETIME,
+	enrolled_at   DATETIME,
+	dropped_at    DATETIME,
+	withdrawn_at  DATETIME,
+	CONSTRAINT enrollments_lecture_id_fkey FOREIGN KEY (lecture_id) REFERENCES lectures(id) ON DELETE CASCADE,
+	CONSTRAINT enrollments_student_id_fkey FOREIGN KEY (student_id) REFERENCES students(id) ON DELETE CASCADE,
+	CONSTRAINT enrollments_term_id_fkey FOREIGN KEY (term_id) REFERENCES terms(id)
+);
+
+-- 삭제된 수강신청 ID를 다시 쓰지 않도록 AUTOINCREMENT 순번을 이어받음
+UPDATE sqlite_sequence SET name = 'enrollments' WHERE name = 'enrollments_old';
+
+INSERT INTO enrollments (id, student_id, lecture_id, term_id, status, waitlisted_at, enrolled_at, dropped_at, withdrawn_at)
+SELECT e.id, e.student_id, e.lecture_id, l.term_id, e.status, e.waitlisted_at, e.enrolled_at, e.dropped_at, e.withdrawn_at
+FROM enrollments_old e
+INNER JOIN lectures l ON l.id = e.lecture_id;
+
+DROP TABLE enrollments_old;
+DROP TABLE lectures_old;
+
+CREATE INDEX IF NOT EXISTS enrollments_student_id_idx ON enrollments(student_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_idx ON enrollments(lecture_id);
+CREATE INDEX IF NOT EXISTS enrollments_lecture_id_status_idx ON enrollments(lecture_id, status);
+CREATE INDEX IF NOT EXISTS enrollments_student_id_term_id_idx ON enrollments(student_id, term_id);
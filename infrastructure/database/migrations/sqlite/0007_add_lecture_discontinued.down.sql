@@ -0,0 +1 @@
+ALTER TABLE lectures DROP COLUMN discontinued;
@@ -0,0 +1 @@
+ALTER TABLE lectures ADD COLUMN discontinued INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS lectures_term_id_room_id_idx;
+ALTER TABLE lectures DROP COLUMN room_id;
+DROP TABLE IF EXISTS rooms;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS rooms (
+	id    TEXT    PRIMARY KEY,
+	name  TEXT    NOT NULL,
+	seats INTEGER NOT NULL CHECK (seats > 0)
+);
+
+-- 강의실이 배정되지 않은 강좌는 NULL, 강좌가 배정된 강의실은 삭제할 수 없음
+ALTER TABLE lectures ADD COLUMN room_id TEXT REFERENCES rooms(id);
+
+CREATE INDEX IF NOT EXISTS lectures_term_id_room_id_idx ON lectures(term_id, room_id);
@@ -0,0 +1,219 @@
+package database
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"golang-course-registration/common/exception"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+// 마이그레이션 대상 DB 종류
+const (
+	DialectSQLite   = "sqlite"
+	DialectPostgres = "postgres"
+)
+
+// Migration 버전별 스키마 변경 (up/down SQL 쌍)
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus 마이그레이션 적용 여부
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, dialect string) (*Migrator, error) {
+	migrations, err := loadMigrations(dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: migrations}, nil
+}
+
+// loadMigrations 바이너리에 포함된 {version}_{name}.{up|down}.sql 파일 로드
+func loadMigrations(dialect string) ([]Migration, error) {
+	dir := path.Join("migrations", dialect)
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", exception.ErrMigrationDialectInvalid, dialect)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		base := strings.TrimSuffix(fileName, ".sql")
+		direction := path.Ext(base)
+		base = strings.TrimSuffix(base, direction)
+
+		versionStr, name, found := strings.Cut(base, "_")
+		version, err := strconv.Atoi(versionStr)
+		if !found || err != nil || (direction != ".up" && direction != ".down") {
+			return nil, fmt.Errorf("%s: %s", exception.ErrMigrationFileInvalid, fileName)
+		}
+
+		content, err := fs.ReadFile(migrationFiles, path.Join(dir, fileName))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if direction == ".up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Up 적용되지 않은 마이그레이션을 버전 순으로 모두 적용
+func (m *Migrator) Up() ([]Migration, error) {
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range m.migrations {
+		if _, exists := applied[migration.Version]; exists {
+			continue
+		}
+		if err := m.apply(migration, true); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+	return done, nil
+}
+
+// Down 가장 최근에 적용된 마이그레이션 하나를 되돌림
+func (m *Migrator) Down() (*Migration, error) {
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, exists := applied[migration.Version]; !exists {
+			continue
+		}
+		if err := m.apply(migration, false); err != nil {
+			return nil, err
+		}
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+// Status 전체 마이그레이션의 적용 여부 조회
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		appliedAt, exists := applied[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Migration: migration,
+			Applied:   exists,
+			AppliedAt: appliedAt,
+		})
+	}
+	return statuses, nil
+}
+
+// apply 스키마 변경과 migrations 테이블 기록을 하나의 트랜잭션으로 처리
+func (m *Migrator) apply(migration Migration, up bool) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	script, record := migration.Down, "DELETE FROM migrations WHERE version = "+m.placeholder(1)
+	args := []interface{}{migration.Version}
+	if up {
+		script = migration.Up
+		record = "INSERT INTO migrations (version, name, applied_at) VALUES (" +
+			m.placeholder(1) + ", " + m.placeholder(2) + ", " + m.placeholder(3) + ")"
+		args = append(args, migration.Name, time.Now().UTC())
+	}
+
+	if _, err := tx.Exec(script); err != nil {
+		return fmt.Errorf("%s: %04d_%s: %w", exception.ErrMigrationFailed, migration.Version, migration.Name, err)
+	}
+	if _, err := tx.Exec(record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *Migrator) appliedVersions() (map[int]time.Time, error) {
+	if _, err := m.db.Exec(`CREATE TABLE IF NOT EXISTS migrations (
+		version    INTEGER   NOT NULL PRIMARY KEY,
+		name       TEXT      NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	)`); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.Query("SELECT version, applied_at FROM migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) placeholder(n int) string {
+	if m.dialect == DialectPostgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrator(t *testing.T) {
+	newMigrator := func(t *testing.T) *Migrator {
+		db, err := OpenSQLite(filepath.Join(t.TempDir(), "test.db"))
+		if err != nil {
+			t.Fatalf("SQLite 연결 실패 : %v", err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		migrator, err := NewMigrator(db, DialectSQLite)
+		if err != nil {
+			t.Fatalf("마이그레이션 로드 실패 : %v", err)
+		}
+		return migrator
+	}
+
+	t.Run("up : 모든 마이그레이션 적용", func(t *testing.T) {
+		// given
+		migrator := newMigrator(t)
+
+		// when
+		_, err := migrator.Up()
+
+		// then
+		statuses, _ := migrator.Status()
+		for _, status := range statuses {
+			if err != nil || !status.Applied {
+				t.Errorf("기대 : %04d 적용, 결과 : 미적용 (%v)", status.Version, err)
+			}
+		}
+	})
+
+	t.Run("up : 이미 적용된 마이그레이션은 건너뜀", func(t *testing.T) {
+		// given
+		migrator := newMigrator(t)
+		_, _ = migrator.Up()
+
+		// when
+		applied, err := migrator.Up()
+
+		// then
+		if err != nil || len(applied) != 0 {
+			t.Errorf("기대 : 0, 결과 : %d (%v)", len(applied), err)
+		}
+	})
+
+	t.Run("down : 가장 최근 마이그레이션만 되돌림", func(t *testing.T) {
+		// given
+		migrator := newMigrator(t)
+		_, _ = migrator.Up()
+
+		// when
+		reverted, err := migrator.Down()
+
+		// then
+		statuses, _ := migrator.Status()
+		last := statuses[len(statuses)-1]
+		if err != nil || reverted == nil || reverted.Version != last.Version || last.Applied {
+			t.Errorf("기대 : %04d 되돌림, 결과 : %+v (%v)", last.Version, reverted, err)
+		}
+	})
+
+	t.Run("예외 : 지원하지 않는 DB", func(t *testing.T) {
+		// when
+		_, err := NewMigrator(nil, "mysql")
+
+		// then
+		if err == nil {
+			t.Error("오류가 발생해야 합니다.")
+		}
+	})
+}
@@ -1,9 +1,11 @@
 package database
 
 import (
+	"database/sql"
 	"fmt"
 	"golang-course-registration/common/exception"
 
+	_ "github.com/jackc/pgx/v5/stdlib"
 	supabase "github.com/supabase-community/supabase-go"
 )
 
@@ -23,3 +25,21 @@ func NewSupabase(url, key string) (*SupabaseStore, error) {
 
 	return &SupabaseStore{Client: client}, nil
 }
+
+// PostgresStore Supabase의 PostgreSQL에 직접 연결 (마이그레이션 용도)
+type PostgresStore struct {
+	DB *sql.DB
+}
+
+func NewPostgres(databaseURL string) (*PostgresStore, error) {
+	if databaseURL == "" {
+		return nil, fmt.Errorf(exception.ErrDatabaseConfigInvalid)
+	}
+
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf(exception.ErrFailedCreateClient)
+	}
+
+	return &PostgresStore{DB: db}, nil
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"golang-course-registration/common/exception"
+
+	_ "modernc.org/sqlite"
+)
+
+type SQLiteStore struct {
+	DB *sql.DB
+}
+
+func NewSQLite(path string) (*SQLiteStore, error) {
+	db, err := OpenSQLite(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// 단일 서버 배포 환경이므로 시작 시 스키마를 최신 버전으로 맞춤
+	migrator, err := NewMigrator(db, DialectSQLite)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := migrator.Up(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteStore{DB: db}, nil
+}
+
+// OpenSQLite 마이그레이션 없이 SQLite 파일 연결
+func OpenSQLite(path string) (*sql.DB, error) {
+	if path == "" {
+		return nil, fmt.Errorf(exception.ErrDatabaseConfigInvalid)
+	}
+
+	dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf(exception.ErrFailedCreateClient)
+	}
+
+	// SQLite는 단일 쓰기만 허용하므로 커넥션을 하나로 제한
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
@@ -0,0 +1,122 @@
+package lock
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+const (
+	advisoryPollMin = 5 * time.Millisecond
+	advisoryPollMax = 100 * time.Millisecond
+)
+
+// advisoryLockManager PostgreSQL 세션 advisory lock으로 여러 서버 간 상호 배제
+type advisoryLockManager struct {
+	db    *sql.DB
+	stats *statsRecorder
+}
+
+func NewAdvisoryLockManager(db *sql.DB) LockManager {
+	return &advisoryLockManager{db: db, stats: newStatsRecorder()}
+}
+
+// Acquire 잠금을 쥔 커넥션을 해제 시점까지 점유하며, 얻을 때까지 pg_try_advisory_lock을 재시도
+func (m *advisoryLockManager) Acquire(ctx context.Context, key string, timeout time.Duration) (Release, error) {
+	if timeout <= 0 {
+		return m.tryAcquire(ctx, key)
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, m.waitFailed(ctx, key, start)
+		}
+		return nil, err
+	}
+
+	lockID := advisoryKey(key)
+	poll := advisoryPollMin
+	contended := false
+	for {
+		var locked bool
+		err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&locked)
+		if err == nil && locked {
+			break
+		}
+		if err != nil && ctx.Err() == nil {
+			conn.Close()
+			return nil, err
+		}
+
+		contended = true
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return nil, m.waitFailed(ctx, key, start)
+		case <-time.After(poll):
+		}
+		poll = min(poll*2, advisoryPollMax)
+	}
+	m.stats.acquired(key, time.Since(start), contended)
+	return advisoryRelease(conn, lockID), nil
+}
+
+// tryAcquire 기다리지 않고 pg_try_advisory_lock을 한 번만 시도, 다른 세션이 쥐고 있으면 ErrTimeout
+func (m *advisoryLockManager) tryAcquire(ctx context.Context, key string) (Release, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockID := advisoryKey(key)
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if !locked {
+		conn.Close()
+		m.stats.timedOut(key, 0)
+		return nil, ErrTimeout
+	}
+	m.stats.acquired(key, 0, false)
+	return advisoryRelease(conn, lockID), nil
+}
+
+// advisoryRelease 잠금을 풀고 점유한 커넥션을 반환하는 해제 함수
+func advisoryRelease(conn *sql.Conn, lockID int64) Release {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+			conn.Close()
+		})
+	}
+}
+
+// waitFailed 마감으로 실패한 경우만 시간 초과 통계에 기록
+func (m *advisoryLockManager) waitFailed(ctx context.Context, key string, start time.Time) error {
+	err := waitError(ctx)
+	if err == ErrTimeout {
+		m.stats.timedOut(key, time.Since(start))
+	}
+	return err
+}
+
+func (m *advisoryLockManager) Stats() []Stat {
+	return m.stats.snapshot()
+}
+
+// advisoryKey 문자열 키를 advisory lock의 bigint 키로 변환
+func advisoryKey(key string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return int64(h.Sum64())
+}
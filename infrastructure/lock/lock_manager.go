@@ -0,0 +1,51 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/common/exception"
+	"strconv"
+	"time"
+)
+
+// ErrTimeout 제한 시간 안에 잠금을 얻지 못함
+var ErrTimeout = exception.ErrLockTimeout
+
+// Release 획득한 잠금 해제 (여러 번 호출해도 한 번만 해제)
+type Release func()
+
+// LockManager 키 단위 상호 배제
+type LockManager interface {
+	// Acquire timeout 또는 ctx 마감 전에 잠금을 얻지 못하면 ErrTimeout, ctx가 취소되면 ctx.Err()
+	// timeout이 0 이하이면 기다리지 않고 한 번만 시도
+	Acquire(ctx context.Context, key string, timeout time.Duration) (Release, error)
+	// Stats 키별 경합 통계 (경합 횟수 내림차순)
+	Stats() []Stat
+}
+
+// waitError 대기 중 ctx가 끝난 원인에 따라 마감이면 ErrTimeout, 취소면 ctx.Err()
+func waitError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return ctx.Err()
+	}
+	return ErrTimeout
+}
+
+// 두 잠금을 함께 쥘 때는 항상 StudentKey → LectureKey 순서로 획득하여 교착 상태를 방지
+// 여러 학생 잠금을 기다려 획득할 때는 학번 오름차순 (강좌 수업 시간 변경)
+// 순서를 어겨야 하면(강좌 잠금을 쥔 채 대기 승격) timeout 0으로 기다리지 않고 시도
+
+// StudentKey 학생별 잠금 키
+func StudentKey(studentID int) string {
+	return "student:" + strconv.Itoa(studentID)
+}
+
+// LectureKey 강좌별 잠금 키
+func LectureKey(lectureID int) string {
+	return "lecture:" + strconv.Itoa(lectureID)
+}
+
+// RoomKey 강의실별 잠금 키 (강의실 배정 검사와 저장을 묶음, 학생 잠금 다음에만 획득하고 쥔 채 다른 잠금을 기다리지 않음)
+func RoomKey(roomID string) string {
+	return "room:" + roomID
+}
@@ -0,0 +1,92 @@
+package lock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryLockManager 단일 프로세스용 잠금. 사용 중인 키만 보관하고 마지막 해제 시 제거
+type memoryLockManager struct {
+	mu      sync.Mutex
+	entries map[string]*memoryLockEntry
+	stats   *statsRecorder
+}
+
+type memoryLockEntry struct {
+	sem  chan struct{}
+	refs int
+}
+
+func NewMemoryLockManager() LockManager {
+	return &memoryLockManager{
+		entries: make(map[string]*memoryLockEntry),
+		stats:   newStatsRecorder(),
+	}
+}
+
+func (m *memoryLockManager) Acquire(ctx context.Context, key string, timeout time.Duration) (Release, error) {
+	entry := m.ref(key)
+	start := time.Now()
+
+	select {
+	case entry.sem <- struct{}{}:
+		m.stats.acquired(key, 0, false)
+	default:
+		if timeout <= 0 {
+			m.unref(key, entry)
+			m.stats.timedOut(key, 0)
+			return nil, ErrTimeout
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		select {
+		case entry.sem <- struct{}{}:
+			m.stats.acquired(key, time.Since(start), true)
+		case <-ctx.Done():
+			m.unref(key, entry)
+			err := waitError(ctx)
+			if err == ErrTimeout {
+				m.stats.timedOut(key, time.Since(start))
+			}
+			return nil, err
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-entry.sem
+			m.unref(key, entry)
+		})
+	}, nil
+}
+
+func (m *memoryLockManager) Stats() []Stat {
+	return m.stats.snapshot()
+}
+
+func (m *memoryLockManager) ref(key string) *memoryLockEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, exists := m.entries[key]
+	if !exists {
+		entry = &memoryLockEntry{sem: make(chan struct{}, 1)}
+		m.entries[key] = entry
+	}
+	entry.refs++
+	return entry
+}
+
+func (m *memoryLockManager) unref(key string, entry *memoryLockEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry.refs--
+	if entry.refs == 0 {
+		delete(m.entries, key)
+	}
+}
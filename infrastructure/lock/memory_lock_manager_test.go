@@ -0,0 +1,149 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryLockManager(t *testing.T) {
+	t.Run("같은 키는 동시에 하나만 획득", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager()
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		inside, maxInside := 0, 0
+
+		// when
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				release, err := manager.Acquire(t.Context(), LectureKey(1001), time.Second)
+				if err != nil {
+					return
+				}
+				defer release()
+
+				mu.Lock()
+				inside++
+				maxInside = max(maxInside, inside)
+				mu.Unlock()
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				inside--
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		// then
+		if maxInside != 1 {
+			t.Errorf("기대 : 1, 결과 : %d", maxInside)
+		}
+	})
+
+	t.Run("예외 : 제한 시간 초과", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager()
+		release, _ := manager.Acquire(t.Context(), LectureKey(1001), time.Second)
+		defer release()
+
+		// when
+		_, err := manager.Acquire(t.Context(), LectureKey(1001), 10*time.Millisecond)
+
+		// then
+		if !errors.Is(err, ErrTimeout) {
+			t.Errorf("기대 : %v, 결과 : %v", ErrTimeout, err)
+		}
+	})
+
+	t.Run("예외 : 제한 시간 0이면 기다리지 않고 한 번만 시도", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager()
+		release, _ := manager.Acquire(t.Context(), StudentKey(1001), time.Second)
+		defer release()
+
+		// when
+		_, err := manager.Acquire(t.Context(), StudentKey(1001), 0)
+		free, errFree := manager.Acquire(t.Context(), StudentKey(1002), 0)
+
+		// then
+		if !errors.Is(err, ErrTimeout) || errFree != nil {
+			t.Errorf("기대 : %v, 다른 키는 획득, 결과 : %v, %v", ErrTimeout, err, errFree)
+		}
+		if free != nil {
+			free()
+		}
+	})
+
+	t.Run("예외 : 대기 중 요청 취소", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager()
+		release, _ := manager.Acquire(t.Context(), LectureKey(1001), time.Second)
+		defer release()
+		ctx, cancel := context.WithCancel(t.Context())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// when
+		start := time.Now()
+		_, err := manager.Acquire(ctx, LectureKey(1001), time.Minute)
+
+		// then
+		if !errors.Is(err, context.Canceled) || time.Since(start) > time.Second {
+			t.Errorf("기대 : %v (즉시 반환), 결과 : %v (%s)", context.Canceled, err, time.Since(start))
+		}
+	})
+
+	t.Run("해제된 키는 제거", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager().(*memoryLockManager)
+		release, _ := manager.Acquire(t.Context(), LectureKey(1001), time.Second)
+
+		// when
+		release()
+		release()
+
+		// then
+		if len(manager.entries) != 0 {
+			t.Errorf("기대 : 0, 결과 : %d", len(manager.entries))
+		}
+	})
+
+	t.Run("경합 통계", func(t *testing.T) {
+		// given
+		manager := NewMemoryLockManager()
+		release, _ := manager.Acquire(t.Context(), LectureKey(1001), time.Second)
+		_, _ = manager.Acquire(t.Context(), LectureKey(1001), time.Millisecond)
+		release()
+		_, _ = manager.Acquire(t.Context(), LectureKey(1002), time.Second)
+
+		// when
+		stats := manager.Stats()
+
+		// then
+		hot := stats[0]
+		if hot.Key != LectureKey(1001) || hot.Acquisitions != 1 || hot.Contentions != 1 || hot.Timeouts != 1 {
+			t.Errorf("기대 : lecture:1001 (획득 1, 경합 1, 시간 초과 1), 결과 : %+v", hot)
+		}
+	})
+
+	t.Run("보관하는 키 수는 상한 이하, 경합이 있었던 키를 먼저 남김", func(t *testing.T) {
+		// given
+		recorder := newStatsRecorder()
+		recorder.timedOut(LectureKey(1000), time.Millisecond)
+
+		// when
+		for i := 0; i < statKeysMax+10; i++ {
+			recorder.acquired(LectureKey(1001+i), 0, false)
+		}
+
+		// then
+		stats := recorder.snapshot()
+		if len(stats) > statKeysMax || stats[0].Key != LectureKey(1000) || stats[0].Timeouts != 1 {
+			t.Errorf("기대 : %d개 이하 (lecture:1000 시간 초과 1), 결과 : %d개 (%+v)", statKeysMax, len(stats), stats[0])
+		}
+	})
+}
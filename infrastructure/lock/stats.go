@@ -0,0 +1,129 @@
+package lock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Stat 키별 잠금 경합 통계
+type Stat struct {
+	Key           string  `json:"key"`
+	Acquisitions  int64   `json:"acquisitions"`
+	Contentions   int64   `json:"contentions"`
+	Timeouts      int64   `json:"timeouts"`
+	AverageWaitMs float64 `json:"average_wait_ms"`
+	MaxWaitMs     float64 `json:"max_wait_ms"`
+}
+
+type counters struct {
+	acquisitions int64
+	contentions  int64
+	timeouts     int64
+	totalWait    time.Duration
+	maxWait      time.Duration
+}
+
+// statKeysMax 통계를 보관하는 최대 키 수
+const statKeysMax = 100
+
+// statsRecorder 잠금 구현체 공통 통계 수집기
+// 잠금한 키 수만큼 늘어나지 않도록 최대 statKeysMax개 키만 보관하고,
+// 가득 차면 경합이 없던 키를 모두 제거한 뒤에도 자리가 없을 때 경합이 가장 적은 키를 제거
+type statsRecorder struct {
+	mu   sync.Mutex
+	keys map[string]*counters
+}
+
+func newStatsRecorder() *statsRecorder {
+	return &statsRecorder{keys: make(map[string]*counters)}
+}
+
+func (r *statsRecorder) acquired(key string, wait time.Duration, contended bool) {
+	r.record(key, wait, func(c *counters) {
+		c.acquisitions++
+		if contended {
+			c.contentions++
+		}
+	})
+}
+
+func (r *statsRecorder) timedOut(key string, wait time.Duration) {
+	r.record(key, wait, func(c *counters) {
+		c.contentions++
+		c.timeouts++
+	})
+}
+
+func (r *statsRecorder) record(key string, wait time.Duration, update func(c *counters)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, exists := r.keys[key]
+	if !exists {
+		if len(r.keys) >= statKeysMax {
+			r.evict()
+		}
+		c = &counters{}
+		r.keys[key] = c
+	}
+	update(c)
+	c.totalWait += wait
+	if wait > c.maxWait {
+		c.maxWait = wait
+	}
+}
+
+// evict 경합이 없던 키를 모두 제거하고, 그래도 가득 차 있으면 경합이 가장 적은 키 하나를 제거
+func (r *statsRecorder) evict() {
+	for key, c := range r.keys {
+		if c.contentions == 0 {
+			delete(r.keys, key)
+		}
+	}
+	if len(r.keys) < statKeysMax {
+		return
+	}
+
+	var victim string
+	var least *counters
+	for key, c := range r.keys {
+		if least == nil || c.contentions < least.contentions || (c.contentions == least.contentions && key > victim) {
+			victim, least = key, c
+		}
+	}
+	delete(r.keys, victim)
+}
+
+func (r *statsRecorder) snapshot() []Stat {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := make([]Stat, 0, len(r.keys))
+	for key, c := range r.keys {
+		attempts := c.acquisitions + c.timeouts
+		stat := Stat{
+			Key:          key,
+			Acquisitions: c.acquisitions,
+			Contentions:  c.contentions,
+			Timeouts:     c.timeouts,
+			MaxWaitMs:    toMillis(c.maxWait),
+		}
+		if attempts > 0 {
+			stat.AverageWaitMs = toMillis(c.totalWait) / float64(attempts)
+		}
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Contentions != stats[j].Contentions {
+			return stats[i].Contentions > stats[j].Contentions
+		}
+		return stats[i].Key < stats[j].Key
+	})
+	return stats
+}
+
+func toMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,151 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang-course-registration/common/exception"
+	"sync"
+	"time"
+)
+
+// ErrUnavailable 회로가 열려 있거나 재시도 후에도 외부 저장소가 응답하지 않음
+var ErrUnavailable = exception.ErrServiceUnavailable
+
+// Unavailable 재시도를 모두 소진한 장애 에러를 ErrUnavailable로 감쌈 (원인은 errors.Unwrap으로 확인)
+func Unavailable(err error) error {
+	return fmt.Errorf("%w: %w", ErrUnavailable, err)
+}
+
+// State 회로 차단기 상태
+type State string
+
+const (
+	StateClosed   State = "closed"    // 정상, 모든 요청 허용
+	StateOpen     State = "open"      // 장애, cooldown 동안 모든 요청 즉시 거부
+	StateHalfOpen State = "half_open" // cooldown 이후 시험 요청 하나만 허용
+)
+
+// BreakerStats 회로 차단기 상태 조회 결과
+type BreakerStats struct {
+	State               State      `json:"state"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	Trips               int64      `json:"trips"`
+	RetryAt             *time.Time `json:"retry_at,omitempty"`
+	LastError           string     `json:"last_error,omitempty"`
+}
+
+// Breaker 연속 실패가 threshold에 도달하면 cooldown 동안 요청을 실행하지 않고 ErrUnavailable로 거부
+// cooldown이 지나면 시험 요청 하나를 보내 성공하면 닫고, 실패하면 다시 염
+type Breaker struct {
+	threshold int
+	cooldown  time.Duration
+	isFailure func(error) bool
+	now       func() time.Time
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	trips     int64
+	lastError string
+}
+
+// NewBreaker isFailure가 true인 에러만 실패로 셈 (조회 결과 없음 같은 정상 응답은 성공)
+func NewBreaker(threshold int, cooldown time.Duration, isFailure func(error) bool) *Breaker {
+	return &Breaker{
+		threshold: max(threshold, 1),
+		cooldown:  cooldown,
+		isFailure: isFailure,
+		now:       time.Now,
+		state:     StateClosed,
+	}
+}
+
+// Call 회로가 허용하면 fn을 실행하고 결과를 기록, 요청 취소/마감은 상태에 반영하지 않음
+func (b *Breaker) Call(ctx context.Context, fn func() error) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+
+	err := fn()
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded), ctx.Err() != nil:
+		b.abandon()
+	case err != nil && b.isFailure(err):
+		b.failure(err)
+	default:
+		b.success()
+	}
+	return err
+}
+
+// Stats 현재 상태와 연속 실패 횟수, 열린 횟수
+func (b *Breaker) Stats() BreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := BreakerStats{
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		Trips:               b.trips,
+		LastError:           b.lastError,
+	}
+	if b.state == StateOpen {
+		retryAt := b.openedAt.Add(b.cooldown)
+		stats.RetryAt = &retryAt
+	}
+	return stats
+}
+
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Before(b.openedAt.Add(b.cooldown)) {
+			return ErrUnavailable
+		}
+		b.state = StateHalfOpen
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrUnavailable
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (b *Breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = StateClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *Breaker) failure(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.lastError = err.Error()
+	b.probing = false
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.state = StateOpen
+		b.openedAt = b.now()
+		b.trips++
+	}
+}
+
+// abandon 시험 요청이 취소되면 다음 요청이 다시 시험할 수 있도록 함
+func (b *Breaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
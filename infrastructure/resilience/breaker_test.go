@@ -0,0 +1,125 @@
+package resilience
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var errStore = errors.New("connection refused")
+var errNotFound = errors.New("not found")
+
+func newTestBreaker() (*Breaker, *time.Time) {
+	now := time.Now()
+	breaker := NewBreaker(3, time.Minute, func(err error) bool { return errors.Is(err, errStore) })
+	breaker.now = func() time.Time { return now }
+	return breaker, &now
+}
+
+func TestBreaker(t *testing.T) {
+	t.Run("연속 실패가 임계값에 도달하면 열리고 즉시 거부", func(t *testing.T) {
+		// given
+		breaker, _ := newTestBreaker()
+		for i := 0; i < 3; i++ {
+			_ = breaker.Call(t.Context(), func() error { return errStore })
+		}
+
+		// when
+		called := false
+		err := breaker.Call(t.Context(), func() error { called = true; return nil })
+
+		// then
+		if !errors.Is(err, ErrUnavailable) || called || breaker.Stats().State != StateOpen {
+			t.Errorf("기대 : %v (호출 안 함), 결과 : %v (호출 %t, %s)", ErrUnavailable, err, called, breaker.Stats().State)
+		}
+	})
+
+	t.Run("장애가 아닌 에러는 실패로 세지 않음", func(t *testing.T) {
+		// given
+		breaker, _ := newTestBreaker()
+
+		// when
+		for i := 0; i < 5; i++ {
+			_ = breaker.Call(t.Context(), func() error { return errNotFound })
+		}
+
+		// then
+		if stats := breaker.Stats(); stats.State != StateClosed || stats.ConsecutiveFailures != 0 {
+			t.Errorf("기대 : %s (실패 0), 결과 : %s (실패 %d)", StateClosed, stats.State, stats.ConsecutiveFailures)
+		}
+	})
+
+	t.Run("cooldown 이후 시험 요청이 성공하면 닫힘", func(t *testing.T) {
+		// given
+		breaker, now := newTestBreaker()
+		for i := 0; i < 3; i++ {
+			_ = breaker.Call(t.Context(), func() error { return errStore })
+		}
+		*now = now.Add(2 * time.Minute)
+
+		// when
+		err := breaker.Call(t.Context(), func() error { return nil })
+
+		// then
+		if err != nil || breaker.Stats().State != StateClosed {
+			t.Errorf("기대 : %s, 결과 : %s %v", StateClosed, breaker.Stats().State, err)
+		}
+	})
+
+	t.Run("시험 요청이 실패하면 다시 열림", func(t *testing.T) {
+		// given
+		breaker, now := newTestBreaker()
+		for i := 0; i < 3; i++ {
+			_ = breaker.Call(t.Context(), func() error { return errStore })
+		}
+		*now = now.Add(2 * time.Minute)
+
+		// when
+		_ = breaker.Call(t.Context(), func() error { return errStore })
+
+		// then
+		if stats := breaker.Stats(); stats.State != StateOpen || stats.Trips != 2 {
+			t.Errorf("기대 : %s (2회), 결과 : %s (%d회)", StateOpen, stats.State, stats.Trips)
+		}
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+	retryable := func(err error) bool { return errors.Is(err, errStore) }
+
+	t.Run("일시적 장애는 성공할 때까지 재시도", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := policy.Do(t.Context(), retryable, func() error {
+			attempts++
+			if attempts < 3 {
+				return errStore
+			}
+			return nil
+		})
+
+		// then
+		if err != nil || attempts != 3 {
+			t.Errorf("기대 : 3회 후 성공, 결과 : %d회 %v", attempts, err)
+		}
+	})
+
+	t.Run("재시도할 수 없는 에러는 한 번만 실행", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := policy.Do(t.Context(), retryable, func() error {
+			attempts++
+			return errNotFound
+		})
+
+		// then
+		if !errors.Is(err, errNotFound) || attempts != 1 {
+			t.Errorf("기대 : 1회, 결과 : %d회 %v", attempts, err)
+		}
+	})
+}
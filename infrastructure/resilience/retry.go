@@ -0,0 +1,56 @@
+package resilience
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy 일시적 장애 시 재시도 횟수와 대기 시간
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// Do retryable이 true인 에러면 최대 MaxAttempts번까지 실행
+// 시도 사이에는 BaseDelay부터 두 배씩(최대 MaxDelay) 늘린 시간의 절반~전체 사이에서 무작위로 대기
+func (p RetryPolicy) Do(ctx context.Context, retryable func(error) bool, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < max(p.MaxAttempts, 1); attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, p.backoff(attempt)); err != nil {
+				return err
+			}
+		}
+
+		err = fn()
+		if err == nil || !retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// backoff attempt번째 재시도 전 대기 시간 (지터 포함)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
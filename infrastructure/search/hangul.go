@@ -0,0 +1,55 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	hangulSyllableFirst = 0xAC00
+	hangulSyllableLast  = 0xD7A3
+	hangulInitialStride = 21 * 28 // 중성 21개 × 종성 28개
+)
+
+// hangulInitials 초성 19자 (호환 자모)
+var hangulInitials = []rune{
+	'ㄱ', 'ㄲ', 'ㄴ', 'ㄷ', 'ㄸ', 'ㄹ', 'ㅁ', 'ㅂ', 'ㅃ', 'ㅅ',
+	'ㅆ', 'ㅇ', 'ㅈ', 'ㅉ', 'ㅊ', 'ㅋ', 'ㅌ', 'ㅍ', 'ㅎ',
+}
+
+// initialOf 완성형 한글 음절의 초성, 한글 음절이 아니면 0
+func initialOf(r rune) rune {
+	if r < hangulSyllableFirst || r > hangulSyllableLast {
+		return 0
+	}
+	return hangulInitials[(r-hangulSyllableFirst)/hangulInitialStride]
+}
+
+// isInitial 초성으로 쓰이는 호환 자모인지 확인
+func isInitial(r rune) bool {
+	for _, initial := range hangulInitials {
+		if r == initial {
+			return true
+		}
+	}
+	return false
+}
+
+// runeMatches 같은 글자이거나, 검색어 글자가 초성이고 대상 음절의 초성과 같으면 일치
+func runeMatches(query, target rune) bool {
+	if query == target {
+		return true
+	}
+	return isInitial(query) && initialOf(target) == query
+}
+
+// normalize 공백을 모두 제거하고 소문자로 변환
+func normalize(s string) []rune {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if !unicode.IsSpace(r) {
+			b.WriteRune(r)
+		}
+	}
+	return []rune(b.String())
+}
@@ -0,0 +1,205 @@
+package search
+
+import (
+	"golang-course-registration/model"
+	"sort"
+	"sync"
+)
+
+// 검색 결과 점수 (높을수록 상위)
+const (
+	ScoreExact       = 100 // 전체 일치
+	ScorePrefix      = 90  // 앞부분 일치
+	ScoreSubstring   = 80  // 부분 일치
+	ScoreSubsequence = 60  // 글자 순서만 일치 (예: "자구" → "자료구조"), 떨어진 글자 수만큼 감점
+	ScoreTypo        = 40  // 오타 허용 일치, 오타 수만큼 감점
+)
+
+// Result 강좌번호와 검색 점수
+type Result struct {
+	LectureID int
+	Score     int
+}
+
+type indexEntry struct {
+	termID string
+	name   []rune
+}
+
+// LectureIndex 강좌명 검색용 인메모리 색인
+// 초성 검색, 공백 무시, 순서 일치, 오타 허용 검색을 지원
+type LectureIndex struct {
+	mu      sync.RWMutex
+	entries map[int]indexEntry
+	ready   bool
+}
+
+func NewLectureIndex() *LectureIndex {
+	return &LectureIndex{entries: make(map[int]indexEntry)}
+}
+
+// Ready 전체 강좌로 색인을 한 번이라도 만들었는지 확인
+func (i *LectureIndex) Ready() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.ready
+}
+
+// Rebuild 전체 강좌로 색인을 다시 만듦
+func (i *LectureIndex) Rebuild(lectures []model.Lecture) {
+	entries := make(map[int]indexEntry, len(lectures))
+	for _, lecture := range lectures {
+		entries[lecture.ID] = indexEntry{termID: lecture.TermID, name: normalize(lecture.Name)}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.entries = entries
+	i.ready = true
+}
+
+// Add 강좌를 색인에 추가 (같은 강좌번호는 덮어씀)
+func (i *LectureIndex) Add(lecture model.Lecture) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.entries[lecture.ID] = indexEntry{termID: lecture.TermID, name: normalize(lecture.Name)}
+}
+
+// Remove 강좌를 색인에서 제거
+func (i *LectureIndex) Remove(lectureID int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.entries, lectureID)
+}
+
+// Search 점수가 높은 순으로 최대 limit개 반환, 점수가 같으면 짧은 강좌명, 강좌번호 순
+// termID가 비어 있지 않으면 해당 학기의 강좌만 검색
+func (i *LectureIndex) Search(query, termID string, limit int) []Result {
+	q := normalize(query)
+	if len(q) == 0 {
+		return []Result{}
+	}
+
+	type candidate struct {
+		Result
+		nameLen int
+	}
+
+	i.mu.RLock()
+	candidates := make([]candidate, 0)
+	for id, entry := range i.entries {
+		if termID != "" && entry.termID != termID {
+			continue
+		}
+		if s := score(q, entry.name); s > 0 {
+			candidates = append(candidates, candidate{Result{LectureID: id, Score: s}, len(entry.name)})
+		}
+	}
+	i.mu.RUnlock()
+
+	sort.Slice(candidates, func(a, b int) bool {
+		if candidates[a].Score != candidates[b].Score {
+			return candidates[a].Score > candidates[b].Score
+		}
+		if candidates[a].nameLen != candidates[b].nameLen {
+			return candidates[a].nameLen < candidates[b].nameLen
+		}
+		return candidates[a].LectureID < candidates[b].LectureID
+	})
+
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	results := make([]Result, 0, len(candidates))
+	for _, c := range candidates {
+		results = append(results, c.Result)
+	}
+	return results
+}
+
+// score 정규화된 검색어와 강좌명의 일치 점수, 일치하지 않으면 0
+func score(query, name []rune) int {
+	if start, ok := findSubstring(query, name); ok {
+		switch {
+		case start == 0 && len(query) == len(name):
+			return ScoreExact
+		case start == 0:
+			return ScorePrefix
+		default:
+			return ScoreSubstring
+		}
+	}
+
+	if len(query) >= 2 {
+		if gaps, ok := subsequenceGaps(query, name); ok {
+			return max(ScoreSubsequence-gaps, ScoreTypo+1)
+		}
+	}
+
+	// 짧은 검색어는 오타를 허용하면 거의 모든 강좌와 일치하므로 3글자 이상만 허용
+	if len(query) >= 3 {
+		if distance := approximateDistance(query, name); distance <= len(query)/3 {
+			return ScoreTypo - 10*(distance-1)
+		}
+	}
+
+	return 0
+}
+
+// findSubstring 검색어가 연속으로 일치하는 첫 위치
+func findSubstring(query, name []rune) (int, bool) {
+	for start := 0; start+len(query) <= len(name); start++ {
+		matched := true
+		for k, r := range query {
+			if !runeMatches(r, name[start+k]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return start, true
+		}
+	}
+	return 0, false
+}
+
+// subsequenceGaps 검색어 글자가 순서대로 모두 나타나면 사이에 끼어 있는 글자 수
+func subsequenceGaps(query, name []rune) (int, bool) {
+	first, k := -1, 0
+	for pos, r := range name {
+		if k < len(query) && runeMatches(query[k], r) {
+			if first < 0 {
+				first = pos
+			}
+			k++
+			if k == len(query) {
+				return pos - first + 1 - len(query), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// approximateDistance 강좌명의 임의 구간과 검색어 사이의 최소 편집 거리
+func approximateDistance(query, name []rune) int {
+	prev := make([]int, len(name)+1)
+	cur := make([]int, len(name)+1)
+	for k, r := range query {
+		cur[0] = k + 1
+		for j := 1; j <= len(name); j++ {
+			cost := 1
+			if runeMatches(r, name[j-1]) {
+				cost = 0
+			}
+			cur[j] = min(prev[j-1]+cost, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+
+	best := len(query)
+	for _, d := range prev {
+		best = min(best, d)
+	}
+	return best
+}
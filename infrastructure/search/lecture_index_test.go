@@ -0,0 +1,97 @@
+package search
+
+import (
+	"golang-course-registration/model"
+	"testing"
+)
+
+func TestLectureIndex(t *testing.T) {
+	newIndex := func() *LectureIndex {
+		index := NewLectureIndex()
+		index.Rebuild([]model.Lecture{
+			{ID: 1001, Name: "자료구조"},
+			{ID: 1002, Name: "컴퓨터 구조"},
+			{ID: 1003, Name: "자료구조 실습"},
+			{ID: 1004, Name: "운영체제"},
+			{ID: 1005, Name: "Database"},
+		})
+		return index
+	}
+
+	testCases := []struct {
+		name        string
+		query       string
+		expectedIDs []int
+	}{
+		{"전체 일치가 가장 먼저", "자료구조", []int{1001, 1003}},
+		{"공백 무시", "컴퓨터구조", []int{1002}},
+		{"초성 검색", "ㅈㄹㄱㅈ", []int{1001, 1003}},
+		{"초성과 음절 혼합", "운ㅇㅊㅈ", []int{1004}},
+		{"글자 순서 일치", "자구", []int{1001, 1003}},
+		{"오타 허용", "운영채제", []int{1004}},
+		{"대소문자 무시", "data", []int{1005}},
+		{"일치하지 않음", "네트워크", []int{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			index := newIndex()
+
+			// when
+			results := index.Search(tc.query, "", 10)
+
+			// then
+			if len(results) != len(tc.expectedIDs) {
+				t.Fatalf("기대 : %v, 결과 : %v", tc.expectedIDs, results)
+			}
+			for i, id := range tc.expectedIDs {
+				if results[i].LectureID != id {
+					t.Errorf("기대 : %v, 결과 : %v", tc.expectedIDs, results)
+				}
+			}
+		})
+	}
+
+	t.Run("부분 일치보다 앞부분 일치가 상위", func(t *testing.T) {
+		// given
+		index := NewLectureIndex()
+		index.Rebuild([]model.Lecture{{ID: 1001, Name: "고급 자료구조"}, {ID: 1002, Name: "자료구조 심화"}})
+
+		// when
+		results := index.Search("자료", "", 10)
+
+		// then
+		if len(results) != 2 || results[0].LectureID != 1002 || results[0].Score != ScorePrefix {
+			t.Errorf("기대 : 1002 (%d점) 먼저, 결과 : %v", ScorePrefix, results)
+		}
+	})
+
+	t.Run("추가 및 삭제 반영", func(t *testing.T) {
+		// given
+		index := newIndex()
+
+		// when
+		index.Add(model.Lecture{ID: 1006, Name: "네트워크"})
+		index.Remove(1004)
+
+		// then
+		if len(index.Search("네트워크", "", 10)) != 1 || len(index.Search("운영체제", "", 10)) != 0 {
+			t.Error("색인에 추가/삭제가 반영되지 않았습니다.")
+		}
+	})
+
+	t.Run("학기를 주면 해당 학기의 강좌만", func(t *testing.T) {
+		// given
+		index := NewLectureIndex()
+		index.Rebuild([]model.Lecture{{ID: 1001, TermID: "2026-2", Name: "자료구조"}, {ID: 2001, TermID: "2027-1", Name: "자료구조"}})
+
+		// when
+		results := index.Search("자료구조", "2027-1", 10)
+
+		// then
+		if len(results) != 1 || results[0].LectureID != 2001 {
+			t.Errorf("기대 : [2001], 결과 : %v", results)
+		}
+	})
+}
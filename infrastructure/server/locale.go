@@ -0,0 +1,51 @@
+package server
+
+import (
+	"golang-course-registration/common/i18n"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	localeParam        = "lang"
+	localeCookie       = "lang"
+	localeCookieMaxAge = 365 * 24 * time.Hour
+)
+
+// localeMiddleware 요청 언어를 정해 요청 컨텍스트에 저장
+// 우선순위는 lang 쿼리 파라미터(선택한 언어를 쿠키에 저장) > lang 쿠키 > Accept-Language 헤더
+func localeMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		locale := resolveLocale(ctx)
+
+		req := ctx.Request()
+		ctx.SetRequest(req.WithContext(i18n.WithLocale(req.Context(), locale)))
+		ctx.Response().Header().Set("Content-Language", string(locale))
+		ctx.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+		return next(ctx)
+	}
+}
+
+func resolveLocale(ctx echo.Context) i18n.Locale {
+	if locale, ok := i18n.Parse(ctx.QueryParam(localeParam)); ok {
+		ctx.SetCookie(&http.Cookie{
+			Name:     localeCookie,
+			Value:    string(locale),
+			Path:     "/",
+			MaxAge:   int(localeCookieMaxAge.Seconds()),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		return locale
+	}
+
+	if cookie, err := ctx.Cookie(localeCookie); err == nil {
+		if locale, ok := i18n.Parse(cookie.Value); ok {
+			return locale
+		}
+	}
+
+	return i18n.Negotiate(ctx.Request().Header.Get("Accept-Language"))
+}
@@ -3,10 +3,14 @@ package server
 import (
 	"fmt"
 	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
 	"golang-course-registration/config"
 	"golang-course-registration/controller/api"
 	"golang-course-registration/controller/web"
 	"golang-course-registration/infrastructure/database"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/infrastructure/resilience"
+	"golang-course-registration/model"
 	"golang-course-registration/repository"
 	"golang-course-registration/service"
 	"html/template"
@@ -19,6 +23,8 @@ import (
 
 type Server struct {
 	Store  *database.SupabaseStore
+	Memory *repository.MemoryStore
+	SQLite *database.SQLiteStore
 	echo   *echo.Echo
 	config *config.Config
 }
@@ -28,12 +34,28 @@ type TemplateRenderer struct {
 }
 
 func New(cfg *config.Config) *Server {
-	store, _ := database.NewSupabase(cfg.Url, cfg.Key)
-	return &Server{
-		Store:  store,
+	s := &Server{
 		echo:   echo.New(),
 		config: cfg,
 	}
+
+	switch cfg.StorageBackend {
+	case config.StorageMemory:
+		s.Memory = repository.NewMemoryStore()
+	case config.StorageSQLite:
+		store, err := database.NewSQLite(cfg.SQLitePath)
+		if err != nil {
+			panic(err)
+		}
+		s.SQLite = store
+	case config.StorageSupabase:
+		store, _ := database.NewSupabase(cfg.Url, cfg.Key)
+		s.Store = store
+	default:
+		panic(fmt.Errorf("%s: %s", exception.ErrStorageBackendInvalid, cfg.StorageBackend))
+	}
+
+	return s
 }
 
 func (s *Server) Init() {
@@ -48,20 +70,40 @@ func (s *Server) Init() {
 		templates: templateCache,
 	}
 	e.Renderer = renderer
-
-	lectureRepo := s.InjectLectureRepository()
-	enrollmentRepo := s.InjectEnrollmentRepository()
-	studentRepo := s.InjectStudentRepository()
-
-	lectureService := s.InjectLectureService(lectureRepo, enrollmentRepo)
+	e.Use(localeMiddleware)
+
+	lectureCache := s.InjectLectureCache()
+	storeBreaker := s.InjectStoreBreaker()
+	lectureRepo := s.InjectLectureRepository(lectureCache, storeBreaker)
+	enrollmentRepo := s.InjectEnrollmentRepository(storeBreaker)
+	studentRepo := s.InjectStudentRepository(storeBreaker)
+	termRepo := s.InjectTermRepository(storeBreaker)
+	roomRepo := s.InjectRoomRepository(storeBreaker)
+	unitOfWork := s.InjectUnitOfWork(lectureCache, storeBreaker)
+	lockManager := s.InjectLockManager()
+
+	enrollmentService := s.InjectEnrollmentService(unitOfWork, enrollmentRepo, lockManager)
+	lectureService := s.InjectLectureService(lectureRepo, enrollmentRepo, termRepo, roomRepo, enrollmentService, lockManager)
 	studentService := s.InjectStudentService(studentRepo)
-	enrollmentService := s.InjectEnrollmentService(enrollmentRepo, lectureRepo, studentRepo)
+	termService := s.InjectTermService(termRepo)
+	roomService := s.InjectRoomService(roomRepo, lectureRepo, lockManager)
+	maintenanceService := s.InjectMaintenanceService(unitOfWork, lectureRepo, lockManager, lectureCache, enrollmentService)
+
+	if s.config.ReconcileInterval > 0 {
+		service.StartReconcileScheduler(maintenanceService, s.config.ReconcileInterval, s.config.ReconcileRepair)
+	}
 
-	adminController := s.InjectAdminController(lectureService)
+	adminController := s.InjectAdminController(lectureService, enrollmentService, maintenanceService, termService, roomService)
 	clientController := s.InjectClientController(studentService, lectureService, enrollmentService)
 	pageController := s.InjectPageController(lectureService, enrollmentService)
+	healthController := s.InjectHealthController(storeBreaker)
+	openAPIController := s.InjectOpenAPIController()
+
+	openAPIController.RegisterRoutes(e.Group("/api"))
 
 	v1 := e.Group("/api/v1")
+	healthController.RegisterRoutes(v1)
+
 	clientGroup := v1.Group("/client")
 	clientController.RegisterRoutes(clientGroup)
 
@@ -105,7 +147,7 @@ func (s *Server) loadTemplates() (map[string]*template.Template, error) {
 		}
 
 		files := append([]string{layout, view}, partials...)
-		tmpl, _ := template.ParseFiles(files...)
+		tmpl, _ := template.New(filepath.Base(layout)).Funcs(templateFuncs(i18n.Default)).ParseFiles(files...)
 
 		relPath, _ := filepath.Rel(templateDir, view)
 		key := filepath.ToSlash(relPath)
@@ -125,24 +167,178 @@ func (t *TemplateRenderer) Render(w io.Writer, name string, data interface{}, c
 		viewContext["reverse"] = c.Echo().Reverse
 	}
 
-	tmpl, _ := t.templates[name]
+	// 요청마다 언어가 다르므로 복제본에 해당 언어의 함수를 연결하여 실행
+	tmpl, err := t.templates[name].Clone()
+	if err != nil {
+		return err
+	}
+	tmpl.Funcs(templateFuncs(i18n.FromContext(c.Request().Context())))
 	return tmpl.ExecuteTemplate(w, filepath.Base(name), data)
 }
 
-func (s *Server) InjectLectureRepository() repository.LectureRepository {
-	return repository.NewLectureRepository(s.Store.Client)
+// templateFuncs 템플릿에서 쓰는 언어별 함수
+// t: 화면 문구, day: 요일 이름, lang: 언어 코드, messages: 스크립트용 전체 메시지
+func templateFuncs(locale i18n.Locale) template.FuncMap {
+	return template.FuncMap{
+		"t":        func(key string) string { return i18n.T(locale, key) },
+		"day":      func(day string) string { return model.Day(day).Name(locale) },
+		"lang":     func() string { return string(locale) },
+		"messages": func() map[string]string { return i18n.Messages(locale) },
+	}
+}
+
+// InjectLectureCache LECTURE_CACHE=false 이면 nil (캐시 사용 안 함)
+func (s *Server) InjectLectureCache() *repository.LectureCache {
+	if !s.config.LectureCacheEnabled {
+		return nil
+	}
+	return repository.NewLectureCache(s.config.LectureCacheTTL)
+}
+
+// InjectStoreBreaker 외부 저장소(supabase)를 사용할 때만 회로 차단기 생성, 그 외에는 nil
+func (s *Server) InjectStoreBreaker() *resilience.Breaker {
+	if s.Store == nil {
+		return nil
+	}
+	return repository.NewStoreBreaker(s.config.BreakerThreshold, s.config.BreakerCooldown)
+}
+
+func (s *Server) storeRetryPolicy() resilience.RetryPolicy {
+	return resilience.RetryPolicy{
+		MaxAttempts: s.config.StoreRetryAttempts,
+		BaseDelay:   s.config.StoreRetryBaseDelay,
+		MaxDelay:    s.config.StoreRetryMaxDelay,
+	}
+}
+
+func (s *Server) InjectLectureRepository(lectureCache *repository.LectureCache, storeBreaker *resilience.Breaker) repository.LectureRepository {
+	var lectureRepo repository.LectureRepository
+	switch {
+	case s.Memory != nil:
+		lectureRepo = repository.NewMemoryLectureRepository(s.Memory)
+	case s.SQLite != nil:
+		lectureRepo = repository.NewSQLiteLectureRepository(s.SQLite.DB)
+	default:
+		lectureRepo = repository.NewResilientLectureRepository(
+			repository.NewLectureRepository(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
+
+	if lectureCache == nil {
+		return lectureRepo
+	}
+	return repository.NewCachedLectureRepository(lectureRepo, lectureCache)
+}
+
+func (s *Server) InjectEnrollmentRepository(storeBreaker *resilience.Breaker) repository.EnrollmentRepository {
+	switch {
+	case s.Memory != nil:
+		return repository.NewMemoryEnrollmentRepository(s.Memory)
+	case s.SQLite != nil:
+		return repository.NewSQLiteEnrollmentRepository(s.SQLite.DB)
+	default:
+		return repository.NewResilientEnrollmentRepository(
+			repository.NewEnrollmentRepository(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
+}
+
+func (s *Server) InjectStudentRepository(storeBreaker *resilience.Breaker) repository.StudentRepository {
+	switch {
+	case s.Memory != nil:
+		return repository.NewMemoryStudentRepository(s.Memory)
+	case s.SQLite != nil:
+		return repository.NewSQLiteStudentRepository(s.SQLite.DB)
+	default:
+		return repository.NewResilientStudentRepository(
+			repository.NewStudentRepository(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
+}
+
+func (s *Server) InjectTermRepository(storeBreaker *resilience.Breaker) repository.TermRepository {
+	switch {
+	case s.Memory != nil:
+		return repository.NewMemoryTermRepository(s.Memory)
+	case s.SQLite != nil:
+		return repository.NewSQLiteTermRepository(s.SQLite.DB)
+	default:
+		return repository.NewResilientTermRepository(
+			repository.NewTermRepository(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
 }
 
-func (s *Server) InjectEnrollmentRepository() repository.EnrollmentRepository {
-	return repository.NewEnrollmentRepository(s.Store.Client)
+func (s *Server) InjectRoomRepository(storeBreaker *resilience.Breaker) repository.RoomRepository {
+	switch {
+	case s.Memory != nil:
+		return repository.NewMemoryRoomRepository(s.Memory)
+	case s.SQLite != nil:
+		return repository.NewSQLiteRoomRepository(s.SQLite.DB)
+	default:
+		return repository.NewResilientRoomRepository(
+			repository.NewRoomRepository(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
 }
 
-func (s *Server) InjectStudentRepository() repository.StudentRepository {
-	return repository.NewStudentRepository(s.Store.Client)
+func (s *Server) InjectUnitOfWork(lectureCache *repository.LectureCache, storeBreaker *resilience.Breaker) repository.UnitOfWork {
+	var unitOfWork repository.UnitOfWork
+	switch {
+	case s.Memory != nil:
+		unitOfWork = repository.NewMemoryUnitOfWork(s.Memory)
+	case s.SQLite != nil:
+		unitOfWork = repository.NewSQLiteUnitOfWork(s.SQLite.DB)
+	default:
+		unitOfWork = repository.NewResilientUnitOfWork(
+			repository.NewSupabaseUnitOfWork(s.Store.Client), storeBreaker, s.storeRetryPolicy())
+	}
+
+	if lectureCache == nil {
+		return unitOfWork
+	}
+	return repository.NewCachedUnitOfWork(unitOfWork, lectureCache)
+}
+
+func (s *Server) InjectLockManager() lock.LockManager {
+	switch s.config.LockBackend {
+	case config.LockMemory:
+		return lock.NewMemoryLockManager()
+	case config.LockPostgres:
+		store, err := database.NewPostgres(s.config.DatabaseURL)
+		if err != nil {
+			panic(err)
+		}
+		return lock.NewAdvisoryLockManager(store.DB)
+	default:
+		panic(fmt.Errorf("%s: %s", exception.ErrLockBackendInvalid, s.config.LockBackend))
+	}
+}
+
+func (s *Server) InjectLectureService(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	termRepo repository.TermRepository,
+	roomRepo repository.RoomRepository,
+	waitlist service.WaitlistPromoter,
+	lockManager lock.LockManager,
+) service.LectureService {
+	return service.NewLectureServiceWithLocks(
+		lectureRepo, enrollmentRepo, termRepo, roomRepo, waitlist,
+		lockManager, s.config.LockTimeout, s.schedulePolicy(), s.activeTerm())
+}
+
+// activeTerm 현재 학기 설정, 형식이 잘못되었으면 서버를 시작하지 않음 (존재 여부는 강좌 등록 시 확인)
+func (s *Server) activeTerm() string {
+	if err := model.ValidateTermID(s.config.ActiveTerm); err != nil {
+		panic(fmt.Errorf("%w: ACTIVE_TERM=%s", err, s.config.ActiveTerm))
+	}
+	return s.config.ActiveTerm
 }
 
-func (s *Server) InjectLectureService(lectureRepo repository.LectureRepository, enrollmentRepo repository.EnrollmentRepository) service.LectureService {
-	return service.NewLectureServiceWithEnrollment(lectureRepo, enrollmentRepo)
+// schedulePolicy 수업 시각 분 단위와 캠퍼스 운영 시간 설정, 잘못된 설정이면 서버를 시작하지 않음
+func (s *Server) schedulePolicy() model.SchedulePolicy {
+	policy, err := model.NewSchedulePolicy(s.config.LectureTimeUnit, s.config.CampusOpenTime, s.config.CampusCloseTime)
+	if err != nil {
+		panic(fmt.Errorf("%w: LECTURE_TIME_UNIT=%d, CAMPUS_OPEN_TIME=%s, CAMPUS_CLOSE_TIME=%s",
+			err, s.config.LectureTimeUnit, s.config.CampusOpenTime, s.config.CampusCloseTime))
+	}
+	return policy
 }
 
 func (s *Server) InjectStudentService(studentRepo repository.StudentRepository) service.StudentService {
@@ -150,15 +346,43 @@ func (s *Server) InjectStudentService(studentRepo repository.StudentRepository)
 }
 
 func (s *Server) InjectEnrollmentService(
+	unitOfWork repository.UnitOfWork,
 	enrollmentRepo repository.EnrollmentRepository,
-	lectureRepo repository.LectureRepository,
-	studentRepo repository.StudentRepository,
+	lockManager lock.LockManager,
 ) service.EnrollmentService {
-	return service.NewEnrollmentService(enrollmentRepo, lectureRepo, studentRepo)
+	return service.NewEnrollmentServiceWithTerm(unitOfWork, enrollmentRepo, lockManager, s.config.LockTimeout, s.activeTerm())
+}
+
+func (s *Server) InjectTermService(termRepo repository.TermRepository) service.TermService {
+	return service.NewTermService(termRepo, s.activeTerm())
 }
 
-func (s *Server) InjectAdminController(lectureService service.LectureService) *api.AdminController {
-	return api.NewAdminController(lectureService)
+func (s *Server) InjectRoomService(
+	roomRepo repository.RoomRepository,
+	lectureRepo repository.LectureRepository,
+	lockManager lock.LockManager,
+) service.RoomService {
+	return service.NewRoomServiceWithLocks(roomRepo, lectureRepo, lockManager, s.config.LockTimeout)
+}
+
+func (s *Server) InjectMaintenanceService(
+	unitOfWork repository.UnitOfWork,
+	lectureRepo repository.LectureRepository,
+	lockManager lock.LockManager,
+	lectureCache *repository.LectureCache,
+	waitlist service.WaitlistPromoter,
+) service.MaintenanceService {
+	return service.NewMaintenanceServiceWithWaitlist(unitOfWork, lectureRepo, lockManager, s.config.LockTimeout, lectureCache, waitlist)
+}
+
+func (s *Server) InjectAdminController(
+	lectureService service.LectureService,
+	enrollmentService service.EnrollmentService,
+	maintenanceService service.MaintenanceService,
+	termService service.TermService,
+	roomService service.RoomService,
+) *api.AdminController {
+	return api.NewAdminController(lectureService, enrollmentService, maintenanceService, termService, roomService, s.config.Timeouts)
 }
 
 func (s *Server) InjectClientController(
@@ -166,7 +390,15 @@ func (s *Server) InjectClientController(
 	lectureService service.LectureService,
 	enrollmentService service.EnrollmentService,
 ) *api.ClientController {
-	return api.NewClientController(studentService, lectureService, enrollmentService)
+	return api.NewClientController(studentService, lectureService, enrollmentService, s.config.Timeouts)
+}
+
+func (s *Server) InjectHealthController(storeBreaker *resilience.Breaker) *api.HealthController {
+	return api.NewHealthController(s.config.StorageBackend, storeBreaker)
+}
+
+func (s *Server) InjectOpenAPIController() *api.OpenAPIController {
+	return api.NewOpenAPIController()
 }
 
 func (s *Server) InjectPageController(lectureService service.LectureService, enrollmentService service.EnrollmentService) *web.PageController {
@@ -3,11 +3,20 @@ package main
 import (
 	"golang-course-registration/config"
 	"golang-course-registration/infrastructure/server"
+	"log"
+	"os"
 )
 
 func main() {
 	cfg := config.Load()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	srv := server.New(cfg)
 	srv.Init()
 	srv.Start()
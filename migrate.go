@@ -0,0 +1,88 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/config"
+	"golang-course-registration/infrastructure/database"
+	"os"
+	"text/tabwriter"
+)
+
+// runMigrate migrate up|down|status 서브커맨드 실행
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) != 1 || (args[0] != "up" && args[0] != "down" && args[0] != "status") {
+		return errors.New(exception.ErrMigrationCommandInvalid)
+	}
+
+	db, dialect, err := openMigrationTarget(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := database.NewMigrator(db, dialect)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, migration := range applied {
+			fmt.Printf("applied  %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		reverted, err := migrator.Down()
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			fmt.Println("no applied migrations")
+			return nil
+		}
+		fmt.Printf("reverted %04d_%s\n", reverted.Version, reverted.Name)
+		return nil
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, status := range statuses {
+			state, appliedAt := "pending", "-"
+			if status.Applied {
+				state, appliedAt = "applied", status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+		}
+		return w.Flush()
+	}
+	return nil
+}
+
+// openMigrationTarget 저장소 백엔드에 맞는 DB 연결과 방언 선택
+func openMigrationTarget(cfg *config.Config) (*sql.DB, string, error) {
+	switch cfg.StorageBackend {
+	case config.StorageSQLite:
+		db, err := database.OpenSQLite(cfg.SQLitePath)
+		return db, database.DialectSQLite, err
+	case config.StorageSupabase:
+		store, err := database.NewPostgres(cfg.DatabaseURL)
+		if err != nil {
+			return nil, "", err
+		}
+		return store.DB, database.DialectPostgres, nil
+	case config.StorageMemory:
+		return nil, "", errors.New(exception.ErrMigrationNotSupported)
+	default:
+		return nil, "", fmt.Errorf("%s: %s", exception.ErrStorageBackendInvalid, cfg.StorageBackend)
+	}
+}
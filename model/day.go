@@ -1,6 +1,12 @@
 package model
 
-import "golang-course-registration/common/constants"
+import (
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
+	"slices"
+	"strings"
+)
 
 type Day string
 
@@ -12,6 +18,44 @@ const (
 	Friday    Day = "FRI"
 )
 
+// Days 강좌를 개설할 수 있는 요일 (월요일부터 순서대로)
+var Days = []Day{Monday, Tuesday, Wednesday, Thursday, Friday}
+
+// dayAliases 요일 코드 외에 허용하는 입력 (소문자), 영어 이름/약어, 한국어 이름/약어, ISO 8601 요일 번호
+var dayAliases = map[Day][]string{
+	Monday:    {"mon", "monday", "월", "월요일", "1"},
+	Tuesday:   {"tue", "tues", "tuesday", "화", "화요일", "2"},
+	Wednesday: {"wed", "wednesday", "수", "수요일", "3"},
+	Thursday:  {"thu", "thur", "thurs", "thursday", "목", "목요일", "4"},
+	Friday:    {"fri", "friday", "금", "금요일", "5"},
+}
+
+// ParseDay 한국어(월요일, 월), 영어(Monday, Mon, 대소문자 무시), ISO 8601 요일 번호(1 = 월요일)를 요일로 변환
+func ParseDay(value string) (Day, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	for _, day := range Days {
+		if slices.Contains(dayAliases[day], normalized) {
+			return day, nil
+		}
+	}
+	return "", exception.ErrLectureDayInvalid
+}
+
+// IsValid 강좌를 개설할 수 있는 요일인지
+func (d Day) IsValid() bool {
+	return slices.Contains(Days, d)
+}
+
+// ISOWeekday ISO 8601 요일 번호 (월요일 1 ~ 금요일 5), 지원하지 않는 요일은 0
+func (d Day) ISOWeekday() int {
+	return slices.Index(Days, d) + 1
+}
+
+// Compare 요일 순서 비교 (월요일이 가장 앞, 지원하지 않는 요일은 모든 요일보다 앞)
+func (d Day) Compare(other Day) int {
+	return d.ISOWeekday() - other.ISOWeekday()
+}
+
 func (d Day) ToKorean() string {
 	switch d {
 	case Monday:
@@ -28,3 +72,11 @@ func (d Day) ToKorean() string {
 		return constants.Undefined
 	}
 }
+
+// Name 해당 언어의 요일 이름, 지원하지 않는 요일은 constants.Undefined
+func (d Day) Name(locale i18n.Locale) string {
+	if !d.IsValid() {
+		return constants.Undefined
+	}
+	return i18n.T(locale, "day."+string(d))
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"testing"
+)
+
+func TestParseDay(t *testing.T) {
+	t.Run("성공 : 한국어, 영어, ISO 요일 번호", func(t *testing.T) {
+		testCases := []struct {
+			value    string
+			expected Day
+		}{
+			{"MON", Monday},
+			{"월요일", Monday},
+			{" 화 ", Tuesday},
+			{"Wednesday", Wednesday},
+			{"thurs", Thursday},
+			{"5", Friday},
+		}
+
+		for _, tc := range testCases {
+			// when
+			day, err := ParseDay(tc.value)
+
+			// then
+			if err != nil || day != tc.expected {
+				t.Errorf("기대 : %s, 결과 : %s (%v)", tc.expected, day, err)
+			}
+		}
+	})
+
+	t.Run("예외 : 강좌를 개설할 수 없는 요일", func(t *testing.T) {
+		for _, value := range []string{"SAT", "일요일", "0", "7", ""} {
+			// when
+			_, err := ParseDay(value)
+
+			// then
+			if !errors.Is(err, exception.ErrLectureDayInvalid) {
+				t.Errorf("기대 : %s, 결과 : %v (%q)", exception.ErrLectureDayInvalid, err, value)
+			}
+		}
+	})
+}
+
+func TestDayCompare(t *testing.T) {
+	// when
+	monday, friday := Monday.ISOWeekday(), Friday.ISOWeekday()
+
+	// then
+	if monday != 1 || friday != 5 || Monday.Compare(Friday) >= 0 || Friday.Compare(Wednesday) <= 0 {
+		t.Errorf("기대 : 월(1) < 수 < 금(5), 결과 : 월 %d, 금 %d", monday, friday)
+	}
+}
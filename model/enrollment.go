@@ -1,28 +1,142 @@
 package model
 
 import (
-	"errors"
 	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
+	"slices"
+	"time"
 )
 
+// EnrollmentStatus 수강신청 상태
+// 대기(WAITLISTED) → 수강(ENROLLED) → 취소(DROPPED), 대기(WAITLISTED) → 대기 제외(WITHDRAWN)
+type EnrollmentStatus string
+
+const (
+	EnrollmentStatusEnrolled   EnrollmentStatus = "ENROLLED"
+	EnrollmentStatusDropped    EnrollmentStatus = "DROPPED"
+	EnrollmentStatusWithdrawn  EnrollmentStatus = "WITHDRAWN"
+	EnrollmentStatusWaitlisted EnrollmentStatus = "WAITLISTED"
+)
+
+// EnrollmentStatuses 허용되는 모든 수강신청 상태
+var EnrollmentStatuses = []EnrollmentStatus{
+	EnrollmentStatusEnrolled,
+	EnrollmentStatusDropped,
+	EnrollmentStatusWithdrawn,
+	EnrollmentStatusWaitlisted,
+}
+
+// ParseEnrollmentStatus 문자열을 수강신청 상태로 변환
+func ParseEnrollmentStatus(value string) (EnrollmentStatus, error) {
+	status := EnrollmentStatus(value)
+	if !slices.Contains(EnrollmentStatuses, status) {
+		return "", exception.ErrEnrollmentStatusInvalid
+	}
+	return status, nil
+}
+
+// Enrollment 학생과 강좌의 수강 관계, 취소하거나 대기에서 제외되어도 이력으로 남음
+// 상태가 바뀐 시각은 상태별로 기록하며 거치지 않은 상태의 시각은 zero value
+// 학기는 강좌의 학기와 같으며, 학기별 학점 제한과 이력 조회에 사용
 type Enrollment struct {
-	ID        int `json:"id"`
-	StudentID int `json:"student_id"`
-	LectureID int `json:"lecture_id"`
+	ID           int              `json:"id"`
+	StudentID    int              `json:"student_id"`
+	LectureID    int              `json:"lecture_id"`
+	TermID       string           `json:"term_id"`
+	Status       EnrollmentStatus `json:"status"`
+	WaitlistedAt time.Time        `json:"waitlisted_at,omitzero"`
+	EnrolledAt   time.Time        `json:"enrolled_at,omitzero"`
+	DroppedAt    time.Time        `json:"dropped_at,omitzero"`
+	WithdrawnAt  time.Time        `json:"withdrawn_at,omitzero"`
 }
 
-func NewEnrollment(studentID, lectureID int) (*Enrollment, error) {
-	if studentID < constants.StudentIdMin || studentID > constants.StudentIdMax {
-		return nil, errors.New(exception.ErrStudentIDInvalid)
+// NewEnrollment 바로 수강하는 수강신청
+func NewEnrollment(studentID, lectureID int, termID string, enrolledAt time.Time) (*Enrollment, error) {
+	if err := validateEnrollmentIDs(studentID, lectureID); err != nil {
+		return nil, err
 	}
 
-	if lectureID <= 0 {
-		return nil, errors.New(exception.ErrEnrollmentLectureIDRequired)
+	return &Enrollment{
+		StudentID:  studentID,
+		LectureID:  lectureID,
+		TermID:     termID,
+		Status:     EnrollmentStatusEnrolled,
+		EnrolledAt: enrolledAt,
+	}, nil
+}
+
+// NewWaitlistedEnrollment 정원이 찬 강좌의 수강 대기, 먼저 등록한 순서(ID 오름차순)대로 빈자리에 승격
+func NewWaitlistedEnrollment(studentID, lectureID int, termID string, waitlistedAt time.Time) (*Enrollment, error) {
+	if err := validateEnrollmentIDs(studentID, lectureID); err != nil {
+		return nil, err
 	}
 
 	return &Enrollment{
-		StudentID: studentID,
-		LectureID: lectureID,
+		StudentID:    studentID,
+		LectureID:    lectureID,
+		TermID:       termID,
+		Status:       EnrollmentStatusWaitlisted,
+		WaitlistedAt: waitlistedAt,
 	}, nil
 }
+
+func validateEnrollmentIDs(studentID, lectureID int) error {
+	if studentID < constants.StudentIdMin || studentID > constants.StudentIdMax {
+		return exception.ErrStudentIDInvalid
+	}
+
+	if lectureID <= 0 {
+		return exception.ErrEnrollmentLectureIDRequired
+	}
+	return nil
+}
+
+// IsActive 정원, 학점, 시간 충돌 계산에 포함되는 수강 중 상태인지
+func (e Enrollment) IsActive() bool {
+	return e.Status == EnrollmentStatusEnrolled
+}
+
+// HasStatus 상태가 statuses 중 하나인지 (statuses가 없으면 모든 상태와 일치)
+func (e Enrollment) HasStatus(statuses ...EnrollmentStatus) bool {
+	return len(statuses) == 0 || slices.Contains(statuses, e.Status)
+}
+
+// Promote 대기 → 수강
+func (e Enrollment) Promote(at time.Time) (Enrollment, error) {
+	if e.Status != EnrollmentStatusWaitlisted {
+		return Enrollment{}, exception.ErrEnrollmentStatusTransition
+	}
+	e.Status = EnrollmentStatusEnrolled
+	e.EnrolledAt = at
+	return e, nil
+}
+
+// Drop 수강 → 취소
+func (e Enrollment) Drop(at time.Time) (Enrollment, error) {
+	if e.Status != EnrollmentStatusEnrolled {
+		return Enrollment{}, exception.ErrEnrollmentStatusTransition
+	}
+	e.Status = EnrollmentStatusDropped
+	e.DroppedAt = at
+	return e, nil
+}
+
+// Withdraw 대기 → 대기 제외 (학생이 대기를 취소했거나 승격할 수 없는 경우)
+func (e Enrollment) Withdraw(at time.Time) (Enrollment, error) {
+	if e.Status != EnrollmentStatusWaitlisted {
+		return Enrollment{}, exception.ErrEnrollmentStatusTransition
+	}
+	e.Status = EnrollmentStatusWithdrawn
+	e.WithdrawnAt = at
+	return e, nil
+}
+
+// WaitlistPosition 대기 목록(등록 순)에서 학생의 순번 (1부터), 대기 중이 아니면 0
+func WaitlistPosition(waitlist []Enrollment, studentID int) int {
+	for i, enrollment := range waitlist {
+		if enrollment.StudentID == studentID {
+			return i + 1
+		}
+	}
+	return 0
+}
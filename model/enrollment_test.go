@@ -1,8 +1,10 @@
 package model
 
 import (
+	"errors"
 	"golang-course-registration/common/exception"
 	"testing"
+	"time"
 )
 
 func TestNewEnrollment(t *testing.T) {
@@ -12,7 +14,7 @@ func TestNewEnrollment(t *testing.T) {
 		lectureID := 5678
 
 		// when
-		enrollment, _ := NewEnrollment(studentID, lectureID)
+		enrollment, _ := NewEnrollment(studentID, lectureID, "2026-2", time.Now())
 
 		// then
 		if enrollment.StudentID != studentID {
@@ -21,6 +23,9 @@ func TestNewEnrollment(t *testing.T) {
 		if enrollment.LectureID != lectureID {
 			t.Errorf("기대 : %d, 결과ㅜ: %d", lectureID, enrollment.LectureID)
 		}
+		if enrollment.TermID != "2026-2" {
+			t.Errorf("기대 : 2026-2, 결과 : %s", enrollment.TermID)
+		}
 	})
 
 	t.Run("예외 : 유효하지 않은 학생 ID", func(t *testing.T) {
@@ -29,10 +34,10 @@ func TestNewEnrollment(t *testing.T) {
 		lectureID := 5678
 
 		// when
-		_, err := NewEnrollment(invalidStudentID, lectureID)
+		_, err := NewEnrollment(invalidStudentID, lectureID, "2026-2", time.Now())
 
 		// then
-		if err.Error() != exception.ErrStudentIDInvalid {
+		if !errors.Is(err, exception.ErrStudentIDInvalid) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrStudentIDInvalid, err)
 		}
 	})
@@ -43,11 +48,67 @@ func TestNewEnrollment(t *testing.T) {
 		invalidLectureID := 0
 
 		// when
-		_, err := NewEnrollment(studentID, invalidLectureID)
+		_, err := NewEnrollment(studentID, invalidLectureID, "2026-2", time.Now())
 
 		// then
-		if err.Error() != exception.ErrEnrollmentLectureIDRequired {
+		if !errors.Is(err, exception.ErrEnrollmentLectureIDRequired) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentLectureIDRequired, err)
 		}
 	})
 }
+
+func TestEnrollmentStatusTransition(t *testing.T) {
+	at := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	t.Run("성공 : 대기 → 수강 → 취소", func(t *testing.T) {
+		// given
+		waiting, _ := NewWaitlistedEnrollment(1234, 5678, "2026-2", at)
+
+		// when
+		enrolled, _ := waiting.Promote(at.Add(time.Hour))
+		dropped, err := enrolled.Drop(at.Add(2 * time.Hour))
+
+		// then
+		if err != nil || dropped.Status != EnrollmentStatusDropped || dropped.IsActive() ||
+			!dropped.WaitlistedAt.Equal(at) || !dropped.EnrolledAt.Equal(at.Add(time.Hour)) || !dropped.DroppedAt.Equal(at.Add(2*time.Hour)) {
+			t.Errorf("기대 : 단계별 시각이 기록된 DROPPED, 결과 : %+v (%v)", dropped, err)
+		}
+	})
+
+	t.Run("성공 : 대기 → 대기 제외", func(t *testing.T) {
+		// given
+		waiting, _ := NewWaitlistedEnrollment(1234, 5678, "2026-2", at)
+
+		// when
+		withdrawn, err := waiting.Withdraw(at)
+
+		// then
+		if err != nil || withdrawn.Status != EnrollmentStatusWithdrawn || !withdrawn.WithdrawnAt.Equal(at) {
+			t.Errorf("기대 : WITHDRAWN, 결과 : %+v (%v)", withdrawn, err)
+		}
+	})
+
+	t.Run("예외 : 취소한 수강신청은 다시 취소할 수 없음", func(t *testing.T) {
+		// given
+		enrolled, _ := NewEnrollment(1234, 5678, "2026-2", at)
+		dropped, _ := enrolled.Drop(at)
+
+		// when
+		_, err := dropped.Drop(at)
+
+		// then
+		if !errors.Is(err, exception.ErrEnrollmentStatusTransition) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentStatusTransition, err)
+		}
+	})
+
+	t.Run("예외 : 허용되지 않는 상태 문자열", func(t *testing.T) {
+		// when
+		_, err := ParseEnrollmentStatus("CANCELLED")
+
+		// then
+		if !errors.Is(err, exception.ErrEnrollmentStatusInvalid) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentStatusInvalid, err)
+		}
+	})
+}
@@ -1,54 +1,39 @@
 package model
 
 import (
-	"errors"
 	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
-	"time"
+	"slices"
 )
 
 type Lectures []Lecture
 
+// Lecture 강좌번호는 모든 학기에서 고유하고, 강좌명은 같은 학기 안에서만 고유
+// Discontinued는 폐강 예정 표시로, 다음 학기로 강좌를 복사할 때 제외
+// RoomID는 배정된 강의실 (비어 있으면 미배정)
 type Lecture struct {
-	ID                int    `json:"id"`
-	Name              string `json:"name"`
-	Capacity          int    `json:"capacity"`
-	CurrentEnrollment int    `json:"current_enrollment"`
-	Credit            int    `json:"credit"`
-	Day               Day    `json:"day"`
-	StartTime         string `json:"start_time"`
-	EndTime           string `json:"end_time"`
+	ID                int           `json:"id"`
+	TermID            string        `json:"term_id"`
+	Name              string        `json:"name"`
+	Capacity          int           `json:"capacity"`
+	CurrentEnrollment int           `json:"current_enrollment"`
+	Credit            int           `json:"credit"`
+	Slots             []MeetingSlot `json:"slots"`
+	RoomID            string        `json:"room_id,omitempty"`
+	Discontinued      bool          `json:"discontinued"`
+	Version           int           `json:"version"`
 }
 
+// NewLecture 주 1회 수업하는 강좌
 func NewLecture(id int, name string, capacity int, credit int, day Day, startTime, endTime string) (*Lecture, error) {
-	li, err := validateLectureId(id)
-	if err != nil {
-		return li, err
-	}
-
-	ln, err := validateLectureName(name)
-	if err != nil {
-		return ln, err
-	}
-
-	lcp, err := validateLectureCapacity(capacity)
-	if err != nil {
-		return lcp, err
-	}
-
-	lc, err := validateLectureCredit(credit)
-	if err != nil {
-		return lc, err
-	}
-
-	ld, err := validateLectureDay(day)
-	if err != nil {
-		return ld, err
-	}
+	return NewLectureWithSlots(id, name, capacity, credit, []MeetingSlotInput{{Day: day, StartTime: startTime, EndTime: endTime}})
+}
 
-	lt, err := validateLectureTime(startTime, endTime)
-	if err != nil {
-		return lt, err
+// NewLectureWithSlots 수업 시간이 여러 개인 강좌 (예: 월/수 09:00 ~ 10:30), 수업 시간은 요일, 시작 시간 순으로 정렬
+func NewLectureWithSlots(id int, name string, capacity int, credit int, inputs []MeetingSlotInput) (*Lecture, error) {
+	slots, fieldErrs := lectureFieldErrors(id, name, capacity, credit, inputs)
+	if err := fieldErrs.Err(); err != nil {
+		return nil, err
 	}
 
 	return &Lecture{
@@ -57,12 +42,66 @@ func NewLecture(id int, name string, capacity int, credit int, day Day, startTim
 		Capacity:          capacity,
 		CurrentEnrollment: 0,
 		Credit:            credit,
-		Day:               day,
-		StartTime:         startTime,
-		EndTime:           endTime,
+		Slots:             slots,
 	}, nil
 }
 
+// ValidateLecture 모든 항목을 검사하여 실패한 필드를 한 번에 반환 (필드 이름은 json 태그, 수업 시간은 slots[0].day 형식)
+func ValidateLecture(id int, name string, capacity int, credit int, inputs []MeetingSlotInput) error {
+	_, fieldErrs := lectureFieldErrors(id, name, capacity, credit, inputs)
+	return fieldErrs.Err()
+}
+
+func lectureFieldErrors(id int, name string, capacity int, credit int, inputs []MeetingSlotInput) ([]MeetingSlot, exception.FieldErrors) {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("id", ValidateLectureID(id))
+	fieldErrs.Add("name", validateLectureName(name))
+	fieldErrs.Add("capacity", validateLectureCapacity(capacity))
+	fieldErrs.Add("credit", validateLectureCredit(credit))
+	slots, slotErrs := parseMeetingSlots(inputs)
+	return slots, append(fieldErrs, slotErrs...)
+}
+
+// Revise 변경 항목을 반영한 강좌, 모든 항목을 다시 검사하고 정원이 현재 수강 인원보다 작으면 거부
+// 강좌번호, 학기, 현재 수강 인원, 강의실, 폐강 예정 표시, 버전은 그대로 유지
+func (l Lecture) Revise(name string, capacity int, credit int, inputs []MeetingSlotInput) (Lecture, error) {
+	slots, fieldErrs := lectureFieldErrors(l.ID, name, capacity, credit, inputs)
+	if !fieldErrs.Has("capacity") && capacity < l.CurrentEnrollment {
+		fieldErrs.Add("capacity", exception.ErrLectureCapacityBelowEnrollment)
+	}
+	if err := fieldErrs.Err(); err != nil {
+		return Lecture{}, err
+	}
+
+	l.Name = name
+	l.Capacity = capacity
+	l.Credit = credit
+	l.Slots = slots
+	return l, nil
+}
+
+// SlotInputs 현재 수업 시간 목록을 입력 형태로 변환 (변경 요청에 수업 시간이 없을 때 그대로 다시 검사)
+func (l Lecture) SlotInputs() []MeetingSlotInput {
+	inputs := make([]MeetingSlotInput, 0, len(l.Slots))
+	for _, slot := range l.Slots {
+		inputs = append(inputs, slot.Input())
+	}
+	return inputs
+}
+
+// HasSameSchedule 수업 시간 목록이 모두 같은지 (시간이 바뀌지 않은 변경은 시간 충돌 검사를 생략)
+func (l *Lecture) HasSameSchedule(other *Lecture) bool {
+	return slices.Equal(l.Slots, other.Slots)
+}
+
+// EarliestStartTime 수업 시간 중 가장 이른 시작 시각 (시작 시간 정렬 기준), 수업 시간이 없으면 0
+func (l *Lecture) EarliestStartTime() TimeOfDay {
+	if len(l.Slots) == 0 {
+		return 0
+	}
+	return slices.MinFunc(l.Slots, func(a, b MeetingSlot) int { return int(a.StartTime - b.StartTime) }).StartTime
+}
+
 func (l *Lecture) IsFull() bool {
 	return l.CurrentEnrollment >= l.Capacity
 }
@@ -79,64 +118,44 @@ func (l *Lecture) DecrementCurrentEnrollment() {
 	}
 }
 
-// HasTimeConflict 시간 중복 검증
+// HasTimeConflict 두 강좌의 수업 시간 중 하나라도 겹치는지 (모든 수업 시간 쌍을 비교)
 func (l *Lecture) HasTimeConflict(other *Lecture) bool {
-	if l.Day != other.Day {
-		return false
-	}
-
-	layout := "15:04"
-	myStart, _ := time.Parse(layout, l.StartTime)
-	myEnd, _ := time.Parse(layout, l.EndTime)
-	otherStart, _ := time.Parse(layout, other.StartTime)
-	otherEnd, _ := time.Parse(layout, other.EndTime)
-	return myStart.Before(otherEnd) && otherStart.Before(myEnd)
-}
-
-func validateLectureTime(startTime string, endTime string) (*Lecture, error) {
-	start, _ := time.Parse("15:04", startTime)
-	end, _ := time.Parse("15:04", endTime)
-	if start.IsZero() || end.IsZero() {
-		return nil, errors.New(exception.ErrLectureTimeRequired)
-	}
-	if !end.After(start) {
-		return nil, errors.New(exception.ErrLectureTimeOrderInvalid)
-	}
-	return nil, nil
-}
-
-func validateLectureDay(day Day) (*Lecture, error) {
-	if day.ToKorean() == "undefined" {
-		return nil, errors.New(exception.ErrLectureDayRequired)
+	for _, mine := range l.Slots {
+		for _, theirs := range other.Slots {
+			if mine.Overlaps(theirs) {
+				return true
+			}
+		}
 	}
-	return nil, nil
+	return false
 }
 
-func validateLectureCredit(credit int) (*Lecture, error) {
+func validateLectureCredit(credit int) error {
 	if credit < constants.LectureCreditMin || credit > constants.LectureCreditMax {
-		return nil, errors.New(exception.ErrLectureCreditInvalid)
+		return exception.ErrLectureCreditInvalid
 	}
-	return nil, nil
+	return nil
 }
 
-func validateLectureCapacity(capacity int) (*Lecture, error) {
+func validateLectureCapacity(capacity int) error {
 	if capacity < constants.LectureCapacityMin || capacity > constants.LectureCapacityMax {
-		return nil, errors.New(exception.ErrLectureCapacityInvalid)
+		return exception.ErrLectureCapacityInvalid
 	}
-	return nil, nil
+	return nil
 }
 
-func validateLectureId(id int) (*Lecture, error) {
+// ValidateLectureID 강좌번호 범위 검사 (수강신청 요청 검증에서도 사용)
+func ValidateLectureID(id int) error {
 	if id < constants.LectureIdMin || id > constants.LectureIdMax {
-		return nil, errors.New(exception.ErrLectureIDInvalid)
+		return exception.ErrLectureIDInvalid
 	}
-	return nil, nil
+	return nil
 }
 
-func validateLectureName(name string) (*Lecture, error) {
+func validateLectureName(name string) error {
 	nameLen := len([]rune(name))
 	if nameLen < constants.LectureNameMin || nameLen > constants.LectureNameMax {
-		return nil, errors.New(exception.ErrLectureNameRequired)
+		return exception.ErrLectureNameRequired
 	}
-	return nil, nil
+	return nil
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"errors"
 	"golang-course-registration/common/exception"
 	"testing"
 )
@@ -31,7 +32,7 @@ func TestNewLecture(t *testing.T) {
 		// when
 		_, err := NewLecture(999, "운영체제", 25, 3, Wednesday, "09:00", "12:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureIDInvalid {
+		if !errors.Is(err, exception.ErrLectureIDInvalid) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureIDInvalid, err)
 		}
 	})
@@ -41,7 +42,7 @@ func TestNewLecture(t *testing.T) {
 		// when
 		_, err := NewLecture(1003, "A", 20, 3, Thursday, "13:00", "15:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureNameRequired {
+		if !errors.Is(err, exception.ErrLectureNameRequired) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNameRequired, err)
 		}
 	})
@@ -51,7 +52,7 @@ func TestNewLecture(t *testing.T) {
 		// when
 		_, err := NewLecture(1004, "네트워크", 50, 3, Friday, "10:00", "12:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureCapacityInvalid {
+		if !errors.Is(err, exception.ErrLectureCapacityInvalid) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureCapacityInvalid, err)
 		}
 	})
@@ -61,7 +62,7 @@ func TestNewLecture(t *testing.T) {
 		// when
 		_, err := NewLecture(1005, "컴퓨터 구조", 30, 7, Monday, "15:00", "18:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureCreditInvalid {
+		if !errors.Is(err, exception.ErrLectureCreditInvalid) {
 			t.Errorf("기대 오류: %s, 결과 : %v", exception.ErrLectureCreditInvalid, err)
 		}
 	})
@@ -69,20 +70,167 @@ func TestNewLecture(t *testing.T) {
 	// given
 	t.Run("예외 : 요일이 지정되지 않은 경우", func(t *testing.T) {
 		// when
-		_, err := NewLecture(1006, "알고리즘", 30, 3, Day("토요일"), "11:00", "14:00")
+		_, err := NewLecture(1006, "알고리즘", 30, 3, "", "11:00", "14:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureDayRequired {
+		if !errors.Is(err, exception.ErrLectureDayRequired) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureDayRequired, err)
 		}
 	})
 
+	// given
+	t.Run("예외 : 강좌를 개설할 수 없는 요일", func(t *testing.T) {
+		// when
+		_, err := NewLecture(1006, "알고리즘", 30, 3, Day("토요일"), "11:00", "14:00")
+		// then
+		if !errors.Is(err, exception.ErrLectureDayInvalid) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureDayInvalid, err)
+		}
+	})
+
+	// given
+	t.Run("예외 : 여러 항목이 유효하지 않으면 모두 반환", func(t *testing.T) {
+		// when
+		_, err := NewLecture(999, "A", 50, 7, Day("토요일"), "09:00", "9시")
+
+		// then
+		expected := []error{
+			exception.ErrLectureIDInvalid, exception.ErrLectureNameRequired, exception.ErrLectureCapacityInvalid,
+			exception.ErrLectureCreditInvalid, exception.ErrLectureDayInvalid, exception.ErrLectureTimeFormatInvalid,
+		}
+		for _, expectedErr := range expected {
+			if !errors.Is(err, expectedErr) {
+				t.Errorf("기대 : %s 포함, 결과 : %v", expectedErr, err)
+			}
+		}
+	})
+
 	// given
 	t.Run("예외 : 강의 종료 시간이 시작 시간보다 빠른 경우", func(t *testing.T) {
 		// when
 		_, err := NewLecture(1007, "데이터베이스", 30, 3, Tuesday, "16:00", "15:00")
 		// then
-		if err == nil || err.Error() != exception.ErrLectureTimeOrderInvalid {
+		if !errors.Is(err, exception.ErrLectureTimeOrderInvalid) {
 			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureTimeOrderInvalid, err)
 		}
 	})
+
+	t.Run("수업 시간이 여러 개인 강좌", func(t *testing.T) {
+		t.Run("성공 : 월/수 같은 시간", func(t *testing.T) {
+			// when
+			lecture, err := NewLectureWithSlots(1008, "운영체제", 30, 3, []MeetingSlotInput{
+				{Day: Monday, StartTime: "09:00", EndTime: "10:30"},
+				{Day: Wednesday, StartTime: "09:00", EndTime: "10:30"},
+			})
+
+			// then
+			if err != nil || len(lecture.Slots) != 2 {
+				t.Errorf("기대 : 수업 시간 2개, 결과 : %+v, %v", lecture, err)
+			}
+		})
+
+		t.Run("예외 : 수업 시간이 없는 경우", func(t *testing.T) {
+			// when
+			_, err := NewLectureWithSlots(1008, "운영체제", 30, 3, nil)
+
+			// then
+			if !errors.Is(err, exception.ErrLectureSlotsInvalid) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureSlotsInvalid, err)
+			}
+		})
+
+		t.Run("예외 : 같은 강좌의 수업 시간끼리 겹치는 경우", func(t *testing.T) {
+			// when
+			_, err := NewLectureWithSlots(1008, "운영체제", 30, 3, []MeetingSlotInput{
+				{Day: Monday, StartTime: "09:00", EndTime: "10:30"},
+				{Day: Monday, StartTime: "10:00", EndTime: "11:30"},
+			})
+
+			// then
+			if !errors.Is(err, exception.ErrLectureSlotsOverlap) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureSlotsOverlap, err)
+			}
+		})
+
+		t.Run("예외 : 두 번째 수업 시간의 필드 이름에 순번 포함", func(t *testing.T) {
+			// when
+			_, err := NewLectureWithSlots(1008, "운영체제", 30, 3, []MeetingSlotInput{
+				{Day: Monday, StartTime: "09:00", EndTime: "10:30"},
+				{Day: Wednesday, StartTime: "11:00", EndTime: "10:30"},
+			})
+
+			// then
+			var fieldErr *exception.Error
+			if !errors.As(err, &fieldErr) || len(fieldErr.Details) != 1 || fieldErr.Details[0].Field != "slots[1].end_time" {
+				t.Errorf("기대 : slots[1].end_time, 결과 : %v", err)
+			}
+		})
+	})
+
+	t.Run("강좌 변경", func(t *testing.T) {
+		t.Run("성공 : 번호, 수강 인원, 버전은 유지", func(t *testing.T) {
+			// given
+			lecture := mustLecture(NewLecture(1001, "데이터베이스", 30, 3, Monday, "09:00", "10:30"))
+			lecture.CurrentEnrollment, lecture.Version = 10, 4
+
+			// when
+			revised, err := lecture.Revise("고급 데이터베이스", 20, 2, []MeetingSlotInput{{Day: Tuesday, StartTime: "13:00", EndTime: "14:30"}})
+
+			// then
+			if err != nil || revised.ID != 1001 || revised.CurrentEnrollment != 10 || revised.Version != 4 ||
+				revised.Name != "고급 데이터베이스" || revised.Capacity != 20 || revised.Slots[0].Day != Tuesday {
+				t.Errorf("기대 : 변경된 항목만 반영, 결과 : %+v, %v", revised, err)
+			}
+		})
+
+		t.Run("예외 : 정원이 현재 수강 인원보다 작은 경우", func(t *testing.T) {
+			// given
+			lecture := mustLecture(NewLecture(1001, "데이터베이스", 30, 3, Monday, "09:00", "10:30"))
+			lecture.CurrentEnrollment = 10
+
+			// when
+			_, err := lecture.Revise("데이터베이스", 9, 3, lecture.SlotInputs())
+
+			// then
+			if !errors.Is(err, exception.ErrLectureCapacityBelowEnrollment) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureCapacityBelowEnrollment, err)
+			}
+		})
+	})
+}
+
+func TestHasTimeConflict(t *testing.T) {
+	// given
+	lecture, _ := NewLectureWithSlots(1001, "운영체제", 30, 3, []MeetingSlotInput{
+		{Day: Monday, StartTime: "09:00", EndTime: "10:30"},
+		{Day: Wednesday, StartTime: "09:00", EndTime: "10:30"},
+	})
+
+	cases := []struct {
+		name     string
+		other    *Lecture
+		expected bool
+	}{
+		{"두 번째 수업 시간과 겹침", mustLecture(NewLecture(1002, "자료구조", 30, 3, Wednesday, "10:00", "12:00")), true},
+		{"끝나는 시각에 시작", mustLecture(NewLecture(1003, "알고리즘", 30, 3, Monday, "10:30", "12:00")), false},
+		{"다른 요일", mustLecture(NewLecture(1004, "네트워크", 30, 3, Tuesday, "09:00", "10:30")), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			conflict := lecture.HasTimeConflict(tc.other)
+
+			// then
+			if conflict != tc.expected || tc.other.HasTimeConflict(lecture) != tc.expected {
+				t.Errorf("기대 : %v, 결과 : %v", tc.expected, conflict)
+			}
+		})
+	}
+}
+
+func mustLecture(lecture *Lecture, err error) *Lecture {
+	if err != nil {
+		panic(err)
+	}
+	return lecture
 }
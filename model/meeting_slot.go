@@ -0,0 +1,94 @@
+package model
+
+import (
+	"cmp"
+	"fmt"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"slices"
+)
+
+// MeetingSlot 강좌의 주간 수업 시간 하나 (예: 월요일 09:00 ~ 10:30)
+type MeetingSlot struct {
+	Day       Day       `json:"day"`
+	StartTime TimeOfDay `json:"start_time"`
+	EndTime   TimeOfDay `json:"end_time"`
+}
+
+// MeetingSlotInput 검증 전 수업 시간 입력, 요일은 한국어/영어/ISO 요일 번호도 허용하고 시간은 "HH:MM"
+type MeetingSlotInput struct {
+	Day       Day
+	StartTime string
+	EndTime   string
+}
+
+// Overlaps 같은 요일에 시간이 겹치는지 (한쪽이 끝나는 시각에 다른 쪽이 시작하면 겹치지 않음)
+func (s MeetingSlot) Overlaps(other MeetingSlot) bool {
+	return s.Day == other.Day && s.StartTime < other.EndTime && other.StartTime < s.EndTime
+}
+
+// Compare 요일, 시작 시각 순서 비교
+func (s MeetingSlot) Compare(other MeetingSlot) int {
+	if byDay := s.Day.Compare(other.Day); byDay != 0 {
+		return byDay
+	}
+	return cmp.Compare(s.StartTime, other.StartTime)
+}
+
+// Input 수업 시간을 입력 형태로 변환
+func (s MeetingSlot) Input() MeetingSlotInput {
+	return MeetingSlotInput{Day: s.Day, StartTime: s.StartTime.String(), EndTime: s.EndTime.String()}
+}
+
+// parseMeetingSlots 수업 시간 개수와 각 수업 시간의 요일, 시각, 순서를 검사하여 변환하고, 모두 올바르면 서로 겹치는지 검사
+// 실패한 필드는 slots[0].day 형식의 이름으로 한 번에 반환, 변환한 목록은 요일, 시작 시각 순으로 정렬
+func parseMeetingSlots(inputs []MeetingSlotInput) ([]MeetingSlot, exception.FieldErrors) {
+	var fieldErrs exception.FieldErrors
+	if len(inputs) < constants.LectureSlotsMin || len(inputs) > constants.LectureSlotsMax {
+		fieldErrs.Add("slots", exception.ErrLectureSlotsInvalid)
+		return nil, fieldErrs
+	}
+
+	slots := make([]MeetingSlot, 0, len(inputs))
+	for i, input := range inputs {
+		slot, slotErrs := parseMeetingSlot(input)
+		for _, slotErr := range slotErrs {
+			slotErr.Field = fmt.Sprintf("slots[%d].%s", i, slotErr.Field)
+			fieldErrs = append(fieldErrs, slotErr)
+		}
+		slots = append(slots, slot)
+	}
+	if len(fieldErrs) > 0 {
+		return nil, fieldErrs
+	}
+
+	slices.SortFunc(slots, MeetingSlot.Compare)
+	for i := 1; i < len(slots); i++ {
+		if slots[i-1].Overlaps(slots[i]) {
+			fieldErrs.Add("slots", exception.ErrLectureSlotsOverlap)
+			return nil, fieldErrs
+		}
+	}
+	return slots, nil
+}
+
+func parseMeetingSlot(input MeetingSlotInput) (MeetingSlot, exception.FieldErrors) {
+	var fieldErrs exception.FieldErrors
+	day, err := parseLectureDay(input.Day)
+	fieldErrs.Add("day", err)
+	startTime, err := ParseTimeOfDay(input.StartTime)
+	fieldErrs.Add("start_time", err)
+	endTime, err := ParseTimeOfDay(input.EndTime)
+	fieldErrs.Add("end_time", err)
+	if !fieldErrs.Has("start_time") && !fieldErrs.Has("end_time") && endTime <= startTime {
+		fieldErrs.Add("end_time", exception.ErrLectureTimeOrderInvalid)
+	}
+	return MeetingSlot{Day: day, StartTime: startTime, EndTime: endTime}, fieldErrs
+}
+
+func parseLectureDay(day Day) (Day, error) {
+	if day == "" {
+		return "", exception.ErrLectureDayRequired
+	}
+	return ParseDay(string(day))
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"regexp"
+)
+
+// roomIDPattern 강의실 번호 (예: ENG-301)
+var roomIDPattern = regexp.MustCompile(`^[A-Z0-9-]{2,20}$`)
+
+// Room 강의실, 배정된 강좌의 정원은 좌석 수를 넘을 수 없고 같은 학기에 수업 시간이 겹치는 강좌를 함께 배정할 수 없음
+type Room struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Seats int    `json:"seats"`
+}
+
+// NewRoom 모든 항목을 검사하여 실패한 필드를 한 번에 반환
+func NewRoom(id, name string, seats int) (*Room, error) {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("id", ValidateRoomID(id))
+	fieldErrs.Add("name", validateRoomName(name))
+	fieldErrs.Add("seats", validateRoomSeats(seats))
+	if err := fieldErrs.Err(); err != nil {
+		return nil, err
+	}
+	return &Room{ID: id, Name: name, Seats: seats}, nil
+}
+
+// Revise 이름과 좌석 수를 바꾼 강의실 (강의실 번호는 유지)
+func (r Room) Revise(name string, seats int) (Room, error) {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("name", validateRoomName(name))
+	fieldErrs.Add("seats", validateRoomSeats(seats))
+	if err := fieldErrs.Err(); err != nil {
+		return Room{}, err
+	}
+
+	r.Name = name
+	r.Seats = seats
+	return r, nil
+}
+
+// ValidateRoomID 강의실 번호 형식 검사 (강좌의 강의실 배정 검증에서도 사용)
+func ValidateRoomID(id string) error {
+	if !roomIDPattern.MatchString(id) {
+		return exception.ErrRoomIDInvalid
+	}
+	return nil
+}
+
+func validateRoomName(name string) error {
+	nameLen := len([]rune(name))
+	if nameLen < constants.RoomNameMin || nameLen > constants.RoomNameMax {
+		return exception.ErrRoomNameInvalid
+	}
+	return nil
+}
+
+func validateRoomSeats(seats int) error {
+	if seats < constants.RoomSeatsMin || seats > constants.RoomSeatsMax {
+		return exception.ErrRoomSeatsInvalid
+	}
+	return nil
+}
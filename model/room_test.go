@@ -0,0 +1,29 @@
+package model
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"testing"
+)
+
+func TestNewRoom(t *testing.T) {
+	t.Run("성공", func(t *testing.T) {
+		// when
+		room, err := NewRoom("ENG-301", "공학관 301호", 40)
+
+		// then
+		if err != nil || room.ID != "ENG-301" || room.Seats != 40 {
+			t.Errorf("기대 : ENG-301 (40석), 결과 : %+v (%v)", room, err)
+		}
+	})
+
+	t.Run("예외 : 강의실 번호와 좌석 수를 함께 검증", func(t *testing.T) {
+		// when
+		_, err := NewRoom("eng 301", "공학관 301호", 0)
+
+		// then
+		if !errors.Is(err, exception.ErrRoomIDInvalid) || !errors.Is(err, exception.ErrRoomSeatsInvalid) {
+			t.Errorf("기대 : %s, %s, 결과 : %v", exception.ErrRoomIDInvalid, exception.ErrRoomSeatsInvalid, err)
+		}
+	})
+}
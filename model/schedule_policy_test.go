@@ -0,0 +1,80 @@
+package model
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"testing"
+)
+
+func TestSchedulePolicyCheck(t *testing.T) {
+	policy, _ := NewSchedulePolicy(30, "08:00", "22:00")
+	slotOf := func(start, end string) []MeetingSlotInput {
+		lecture, err := NewLecture(1001, "데이터베이스", 30, 3, Monday, start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return lecture.SlotInputs()
+	}
+
+	t.Run("성공", func(t *testing.T) {
+		// when
+		err := policy.Check(slotOf("08:00", "22:00"))
+
+		// then
+		if err != nil {
+			t.Errorf("기대 : nil, 결과 : %v", err)
+		}
+	})
+
+	t.Run("예외 : 분 단위에 맞지 않는 시각", func(t *testing.T) {
+		// when
+		err := policy.Check(slotOf("09:15", "10:30"))
+
+		// then
+		var validationErr *exception.Error
+		if !errors.As(err, &validationErr) || len(validationErr.Details) != 1 ||
+			validationErr.Details[0].Field != "slots[0].start_time" || validationErr.Details[0].Err != exception.ErrLectureTimeGranularity {
+			t.Errorf("기대 : slots[0].start_time %s, 결과 : %v", exception.ErrLectureTimeGranularity, err)
+		}
+	})
+
+	t.Run("예외 : 요일 순서가 아닌 여러 수업 시간은 요청한 순서의 번호로 반환", func(t *testing.T) {
+		// given
+		inputs := []MeetingSlotInput{
+			{Day: Friday, StartTime: "09:00", EndTime: "10:30"},
+			{Day: Monday, StartTime: "09:07", EndTime: "10:30"},
+		}
+
+		// when
+		err := policy.Check(inputs)
+
+		// then
+		var validationErr *exception.Error
+		if !errors.As(err, &validationErr) || len(validationErr.Details) != 1 ||
+			validationErr.Details[0].Field != "slots[1].start_time" || validationErr.Details[0].Err != exception.ErrLectureTimeGranularity {
+			t.Errorf("기대 : slots[1].start_time %s, 결과 : %v", exception.ErrLectureTimeGranularity, err)
+		}
+	})
+
+	t.Run("예외 : 운영 시간 밖의 수업", func(t *testing.T) {
+		// when
+		err := policy.Check(slotOf("21:00", "22:30"))
+
+		// then
+		var validationErr *exception.Error
+		if !errors.As(err, &validationErr) || len(validationErr.Details) != 1 ||
+			validationErr.Details[0].Field != "slots[0].end_time" || validationErr.Details[0].Err != exception.ErrLectureTimeOutsideHours {
+			t.Errorf("기대 : slots[0].end_time %s, 결과 : %v", exception.ErrLectureTimeOutsideHours, err)
+		}
+	})
+
+	t.Run("예외 : 잘못된 정책 설정", func(t *testing.T) {
+		// when
+		_, err := NewSchedulePolicy(30, "22:00", "08:00")
+
+		// then
+		if !errors.Is(err, exception.ErrSchedulePolicyInvalid) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrSchedulePolicyInvalid, err)
+		}
+	})
+}
@@ -1,7 +1,6 @@
 package model
 
 import (
-	"errors"
 	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
 )
@@ -11,9 +10,17 @@ type Student struct {
 }
 
 func NewStudent(id int) (*Student, error) {
-	if id < constants.StudentIdMin || id > constants.StudentIdMax {
-		return nil, errors.New(exception.ErrStudentIDInvalid)
+	if err := ValidateStudentID(id); err != nil {
+		return nil, err
 	}
 
 	return &Student{ID: id}, nil
 }
+
+// ValidateStudentID 학번 범위 검사 (수강신청 요청 검증에서도 사용)
+func ValidateStudentID(id int) error {
+	if id < constants.StudentIdMin || id > constants.StudentIdMax {
+		return exception.ErrStudentIDInvalid
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"errors"
 	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
 	"testing"
@@ -38,7 +39,7 @@ func TestNewStudent(t *testing.T) {
 				if err == nil {
 					t.Error("오류가 발생해야 합니다.")
 				}
-				if err.Error() != exception.ErrStudentIDInvalid {
+				if !errors.Is(err, exception.ErrStudentIDInvalid) {
 					t.Errorf("기대 오류: %s, 실제 오류: %v", exception.ErrStudentIDInvalid, err)
 				}
 			})
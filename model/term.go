@@ -0,0 +1,51 @@
+package model
+
+import (
+	"golang-course-registration/common/exception"
+	"regexp"
+	"time"
+)
+
+// TermDateLayout 학기 시작/종료일 형식
+const TermDateLayout = "2006-01-02"
+
+// termIDPattern 연도-학기 (예: 2026-1, 2026-2)
+var termIDPattern = regexp.MustCompile(`^[0-9]{4}-[12]$`)
+
+// Term 학기, 강좌와 수강신청은 하나의 학기에 속함
+type Term struct {
+	ID        string    `json:"id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// NewTerm "YYYY-MM-DD" 형식의 시작/종료일로 학기 생성
+func NewTerm(id, startDate, endDate string) (*Term, error) {
+	var fieldErrs exception.FieldErrors
+	fieldErrs.Add("id", ValidateTermID(id))
+
+	start, err := time.Parse(TermDateLayout, startDate)
+	if err != nil {
+		fieldErrs.Add("start_date", exception.ErrTermDateInvalid)
+	}
+	end, err := time.Parse(TermDateLayout, endDate)
+	if err != nil {
+		fieldErrs.Add("end_date", exception.ErrTermDateInvalid)
+	}
+	if !fieldErrs.Has("start_date") && !fieldErrs.Has("end_date") && !end.After(start) {
+		fieldErrs.Add("end_date", exception.ErrTermDateOrderInvalid)
+	}
+
+	if err := fieldErrs.Err(); err != nil {
+		return nil, err
+	}
+	return &Term{ID: id, StartDate: start, EndDate: end}, nil
+}
+
+// ValidateTermID 학기 형식 검사 (조회 조건의 학기 검증에서도 사용)
+func ValidateTermID(id string) error {
+	if !termIDPattern.MatchString(id) {
+		return exception.ErrTermIDInvalid
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"testing"
+	"time"
+)
+
+func TestNewTerm(t *testing.T) {
+	t.Run("성공", func(t *testing.T) {
+		// when
+		term, err := NewTerm("2027-1", "2027-03-02", "2027-06-20")
+
+		// then
+		if err != nil || term.ID != "2027-1" || !term.StartDate.Equal(time.Date(2027, 3, 2, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("기대 : 2027-1 (2027-03-02 ~), 결과 : %+v (%v)", term, err)
+		}
+	})
+
+	t.Run("예외 : 학기 형식과 날짜를 함께 검증", func(t *testing.T) {
+		// when
+		_, err := NewTerm("2027-3", "2027/03/02", "2027-06-20")
+
+		// then
+		if !errors.Is(err, exception.ErrTermIDInvalid) || !errors.Is(err, exception.ErrTermDateInvalid) {
+			t.Errorf("기대 : %s, %s, 결과 : %v", exception.ErrTermIDInvalid, exception.ErrTermDateInvalid, err)
+		}
+	})
+
+	t.Run("예외 : 종료일이 시작일보다 빠름", func(t *testing.T) {
+		// when
+		_, err := NewTerm("2027-1", "2027-06-20", "2027-03-02")
+
+		// then
+		if !errors.Is(err, exception.ErrTermDateOrderInvalid) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrTermDateOrderInvalid, err)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"golang-course-registration/common/exception"
+	"time"
+)
+
+// TimeOfDay 하루 중 시각 (자정부터 지난 분), JSON과 DB에는 "HH:MM" 문자열로 저장
+type TimeOfDay int
+
+const timeOfDayLayout = "15:04"
+
+// NewTimeOfDay 시와 분으로 시각 생성 (00:00 ~ 23:59)
+func NewTimeOfDay(hour, minute int) (TimeOfDay, error) {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, exception.ErrLectureTimeFormatInvalid
+	}
+	return TimeOfDay(hour*60 + minute), nil
+}
+
+// ParseTimeOfDay "HH:MM" 형식(시와 분 모두 두 자리)의 문자열을 시각으로 변환
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	if value == "" {
+		return 0, exception.ErrLectureTimeRequired
+	}
+
+	parsed, err := time.Parse(timeOfDayLayout, value)
+	if err != nil || len(value) != len(timeOfDayLayout) {
+		return 0, exception.ErrLectureTimeFormatInvalid
+	}
+	return NewTimeOfDay(parsed.Hour(), parsed.Minute())
+}
+
+func (t TimeOfDay) Hour() int {
+	return int(t) / 60
+}
+
+func (t TimeOfDay) Minute() int {
+	return int(t) % 60
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", t.Hour(), t.Minute())
+}
+
+// MarshalText JSON 문자열 "HH:MM"으로 변환
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText 저장된 값이 "HH:MM" 형식이 아니면 오류 (잘못된 데이터를 조용히 00:00으로 읽지 않음)
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return fmt.Errorf("%w: %q", err, text)
+	}
+	*t = parsed
+	return nil
+}
+
+// Value DB 컬럼에 "HH:MM" 문자열로 저장
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+// Scan DB 컬럼의 "HH:MM" 문자열을 읽음
+func (t *TimeOfDay) Scan(src any) error {
+	switch value := src.(type) {
+	case string:
+		return t.UnmarshalText([]byte(value))
+	case []byte:
+		return t.UnmarshalText(value)
+	default:
+		return fmt.Errorf("%w: %T", exception.ErrLectureTimeFormatInvalid, src)
+	}
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"golang-course-registration/common/exception"
+	"testing"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	t.Run("성공", func(t *testing.T) {
+		// when
+		timeOfDay, err := ParseTimeOfDay("09:05")
+
+		// then
+		if err != nil || timeOfDay.Hour() != 9 || timeOfDay.Minute() != 5 || timeOfDay.String() != "09:05" {
+			t.Errorf("기대 : 09:05, 결과 : %s (%v)", timeOfDay, err)
+		}
+	})
+
+	t.Run("예외 : 형식 오류", func(t *testing.T) {
+		for _, value := range []string{"9:05", "24:00", "09:60", "9시", "09:05:00"} {
+			// when
+			_, err := ParseTimeOfDay(value)
+
+			// then
+			if !errors.Is(err, exception.ErrLectureTimeFormatInvalid) {
+				t.Errorf("기대 : %s, 결과 : %v (%q)", exception.ErrLectureTimeFormatInvalid, err, value)
+			}
+		}
+	})
+}
+
+func TestTimeOfDayJSON(t *testing.T) {
+	t.Run("성공 : HH:MM 문자열로 변환", func(t *testing.T) {
+		// given
+		slot := MeetingSlot{Day: Monday, StartTime: TimeOfDay(9 * 60), EndTime: TimeOfDay(10*60 + 30)}
+
+		// when
+		data, _ := json.Marshal(slot)
+		var decoded MeetingSlot
+		err := json.Unmarshal(data, &decoded)
+
+		// then
+		expected := `{"day":"MON","start_time":"09:00","end_time":"10:30"}`
+		if string(data) != expected || err != nil || decoded != slot {
+			t.Errorf("기대 : %s, 결과 : %s (%v)", expected, data, err)
+		}
+	})
+
+	t.Run("예외 : 저장된 시각이 손상됨", func(t *testing.T) {
+		// given
+		data := `{"day":"MON","start_time":"9시","end_time":"10:30"}`
+
+		// when
+		var decoded MeetingSlot
+		err := json.Unmarshal([]byte(data), &decoded)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureTimeFormatInvalid) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureTimeFormatInvalid, err)
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/model"
+)
+
+type cachedLectureRepository struct {
+	inner LectureRepository
+	cache *LectureCache
+	// deferred 작업 단위 안에서는 커밋 전 무효화가 의미 없으므로 변경된 강좌를 모아 두었다가 작업 종료 후 무효화
+	deferred *[]int
+	dirty    bool
+}
+
+// NewCachedLectureRepository 조회 결과를 cache에 보관하고, 등록/삭제/정보 변경/수강 인원 변경 시 무효화
+func NewCachedLectureRepository(inner LectureRepository, cache *LectureCache) LectureRepository {
+	return &cachedLectureRepository{inner: inner, cache: cache}
+}
+
+func (r *cachedLectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
+	if r.dirty {
+		return r.inner.FindAll(ctx)
+	}
+	if lectures, ok := r.cache.getAll(); ok {
+		return lectures, nil
+	}
+
+	gen := r.cache.generation()
+	lectures, err := r.inner.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.putAll(gen, lectures)
+	return lectures, nil
+}
+
+func (r *cachedLectureRepository) FindPage(ctx context.Context, query LectureQuery) (LecturePage, error) {
+	if r.dirty {
+		return r.inner.FindPage(ctx, query)
+	}
+	if page, ok := r.cache.getPage(query); ok {
+		return page, nil
+	}
+
+	gen := r.cache.generation()
+	page, err := r.inner.FindPage(ctx, query)
+	if err != nil {
+		return LecturePage{}, err
+	}
+	r.cache.putPage(gen, query, page)
+	return page, nil
+}
+
+func (r *cachedLectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
+	if r.dirty {
+		return r.inner.FindByID(ctx, id)
+	}
+	if lecture, ok := r.cache.getByID(id); ok {
+		return lecture, nil
+	}
+
+	gen := r.cache.generation()
+	lecture, err := r.inner.FindByID(ctx, id)
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	r.cache.putByID(gen, lecture)
+	return lecture, nil
+}
+
+func (r *cachedLectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
+	if r.dirty {
+		return r.inner.FindByName(ctx, termID, name)
+	}
+	if lecture, ok := r.cache.getByName(termID, name); ok {
+		return lecture, nil
+	}
+
+	gen := r.cache.generation()
+	lecture, err := r.inner.FindByName(ctx, termID, name)
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	r.cache.putByName(gen, lecture)
+	return lecture, nil
+}
+
+func (r *cachedLectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
+	created, err := r.inner.Create(ctx, lecture)
+	r.invalidate(lecture.ID)
+	return created, err
+}
+
+func (r *cachedLectureRepository) Delete(ctx context.Context, id int) error {
+	err := r.inner.Delete(ctx, id)
+	r.invalidate(id)
+	return err
+}
+
+// UpdateCurrentEnrollment 버전 충돌이면 캐시의 강좌가 오래된 것이므로 함께 무효화하여 재시도 시 새로 읽게 함
+func (r *cachedLectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
+	err := r.inner.UpdateCurrentEnrollment(ctx, lectureID, currentEnrollment, expectedVersion)
+
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		r.cache.InvalidateLecture(lectureID)
+		return err
+	}
+	r.invalidate(lectureID)
+	return err
+}
+
+// Update 강좌명이 바뀌면 이전 이름의 조회 캐시도 InvalidateLecture가 함께 비움
+func (r *cachedLectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	updated, err := r.inner.Update(ctx, lecture, expectedVersion)
+
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		r.cache.InvalidateLecture(lecture.ID)
+		return model.Lecture{}, err
+	}
+	r.invalidate(lecture.ID)
+	return updated, err
+}
+
+func (r *cachedLectureRepository) invalidate(lectureID int) {
+	if r.deferred == nil {
+		r.cache.InvalidateLecture(lectureID)
+		return
+	}
+	*r.deferred = append(*r.deferred, lectureID)
+	r.dirty = true
+}
+
+type cachedUnitOfWork struct {
+	inner UnitOfWork
+	cache *LectureCache
+}
+
+// NewCachedUnitOfWork 작업 단위 안의 강좌 조회에 cache를 사용하고, 변경된 강좌는 작업이 끝난 뒤 무효화
+// 캐시의 강좌가 오래되었더라도 버전 검사로 갱신이 거부되므로 재시도 시 새로 읽음
+func NewCachedUnitOfWork(inner UnitOfWork, cache *LectureCache) UnitOfWork {
+	return &cachedUnitOfWork{inner: inner, cache: cache}
+}
+
+func (u *cachedUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	var changed []int
+	defer func() {
+		for _, lectureID := range changed {
+			u.cache.InvalidateLecture(lectureID)
+		}
+	}()
+
+	return u.inner.Do(ctx, func(repos Repositories) error {
+		repos.Lectures = &cachedLectureRepository{inner: repos.Lectures, cache: u.cache, deferred: &changed}
+		return fn(repos)
+	})
+}
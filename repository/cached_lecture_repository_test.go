@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"errors"
+	"golang-course-registration/model"
+	"testing"
+	"time"
+)
+
+func TestCachedLectureRepository(t *testing.T) {
+	newCachedRepo := func() (LectureRepository, LectureRepository, *LectureCache) {
+		inner := NewMemoryLectureRepository(NewMemoryStore())
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = inner.Create(t.Context(), *lecture)
+		cache := NewLectureCache(time.Minute)
+		return NewCachedLectureRepository(inner, cache), inner, cache
+	}
+
+	t.Run("두 번째 조회는 캐시 적중", func(t *testing.T) {
+		// given
+		repo, _, cache := newCachedRepo()
+
+		// when
+		_, _ = repo.FindByID(t.Context(), 1001)
+		_, _ = repo.FindByID(t.Context(), 1001)
+
+		// then
+		stats := cache.Stats()
+		if stats.Hits != 1 || stats.Misses != 1 {
+			t.Errorf("기대 : (적중 1, 실패 1), 결과 : (%d, %d)", stats.Hits, stats.Misses)
+		}
+	})
+
+	t.Run("TTL이 지나면 다시 조회", func(t *testing.T) {
+		// given
+		repo, _, cache := newCachedRepo()
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+		_, _ = repo.FindAll(t.Context())
+
+		// when
+		now = now.Add(2 * time.Minute)
+		_, _ = repo.FindAll(t.Context())
+
+		// then
+		if stats := cache.Stats(); stats.Misses != 2 {
+			t.Errorf("기대 : 실패 2, 결과 : %d", stats.Misses)
+		}
+	})
+
+	t.Run("등록 시 목록 무효화", func(t *testing.T) {
+		// given
+		repo, _, _ := newCachedRepo()
+		_, _ = repo.FindAll(t.Context())
+		lecture, _ := model.NewLecture(1002, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+
+		// when
+		_, _ = repo.Create(t.Context(), *lecture)
+
+		// then
+		lectures, _ := repo.FindAll(t.Context())
+		if len(lectures) != 2 {
+			t.Errorf("기대 : 2, 결과 : %d", len(lectures))
+		}
+	})
+
+	t.Run("수강 인원 변경 시 무효화", func(t *testing.T) {
+		// given
+		repo, _, _ := newCachedRepo()
+		_, _ = repo.FindByID(t.Context(), 1001)
+
+		// when
+		_ = repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// then
+		lecture, _ := repo.FindByID(t.Context(), 1001)
+		if lecture.CurrentEnrollment != 1 || lecture.Version != 1 {
+			t.Errorf("기대 : (1, 버전 1), 결과 : (%d, 버전 %d)", lecture.CurrentEnrollment, lecture.Version)
+		}
+	})
+
+	t.Run("오래된 캐시로 갱신 시 버전 충돌 후 무효화", func(t *testing.T) {
+		// given
+		repo, inner, _ := newCachedRepo()
+		stale, _ := repo.FindByID(t.Context(), 1001)
+		_ = inner.UpdateCurrentEnrollment(t.Context(), 1001, 5, 0)
+
+		// when
+		err := repo.UpdateCurrentEnrollment(t.Context(), 1001, stale.CurrentEnrollment+1, stale.Version)
+
+		// then
+		var conflict *ConflictError
+		fresh, _ := repo.FindByID(t.Context(), 1001)
+		if !errors.As(err, &conflict) || fresh.CurrentEnrollment != 5 {
+			t.Errorf("기대 : 버전 충돌 후 5, 결과 : %v, %d", err, fresh.CurrentEnrollment)
+		}
+	})
+}
+
+func TestCachedUnitOfWork(t *testing.T) {
+	t.Run("작업 단위에서 변경한 강좌는 종료 후 무효화", func(t *testing.T) {
+		// given
+		store := NewMemoryStore()
+		cache := NewLectureCache(time.Minute)
+		repo := NewCachedLectureRepository(NewMemoryLectureRepository(store), cache)
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), *lecture)
+		_, _ = repo.FindByID(t.Context(), 1001)
+		uow := NewCachedUnitOfWork(NewMemoryUnitOfWork(store), cache)
+
+		// when
+		_ = uow.Do(t.Context(), func(repos Repositories) error {
+			found, _ := repos.Lectures.FindByID(t.Context(), 1001)
+			return repos.Lectures.UpdateCurrentEnrollment(t.Context(), 1001, found.CurrentEnrollment+1, found.Version)
+		})
+
+		// then
+		updated, _ := repo.FindByID(t.Context(), 1001)
+		if updated.CurrentEnrollment != 1 {
+			t.Errorf("기대 : 1, 결과 : %d", updated.CurrentEnrollment)
+		}
+	})
+}
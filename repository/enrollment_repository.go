@@ -1,93 +1,206 @@
 package repository
 
 import (
+	"context"
+	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
 	"strconv"
+	"time"
 
 	"github.com/supabase-community/postgrest-go"
 	"github.com/supabase-community/supabase-go"
 )
 
 type EnrollmentRepository interface {
-	Create(enrollment model.Enrollment) (model.Enrollment, error)
-	FindByStudent(studentID int) ([]model.Enrollment, error)
-	FindLecturesByStudent(studentID int) ([]model.Lecture, error)
-	CountByLectureID(lectureID int) (int, error)
-	DeleteByStudentAndLecture(studentID, lectureID int) error
+	Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error)
+	// FindByStudent 학생의 수강신청 이력 (최근 순), statuses를 주면 해당 상태만
+	FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error)
+	// FindByLecture 강좌의 수강신청 (먼저 등록한 순), statuses를 주면 해당 상태만
+	FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error)
+	// FindLecturesByStudent 학생이 수강 중(ENROLLED)인 강좌, termID가 비어 있으면 모든 학기
+	FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error)
+	// CountByLectureID 강좌를 수강 중(ENROLLED)인 인원
+	CountByLectureID(ctx context.Context, lectureID int) (int, error)
+	// FindStudentIDsByLecture 강좌를 수강 중(ENROLLED)인 학생의 학번 (오름차순)
+	FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error)
+	// UpdateStatus 상태와 상태별 시각을 저장, 저장된 상태가 previous가 아니면 ErrEnrollmentNotFound
+	UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error
 }
 
 type enrollmentRepository struct {
 	client *supabase.Client
+	undo   *compensationLog
 }
 
 type enrollmentRecord struct {
-	ID        int `json:"id"`
-	StudentID int `json:"student_id"`
-	LectureID int `json:"lecture_id"`
+	ID           int                    `json:"id"`
+	StudentID    int                    `json:"student_id"`
+	LectureID    int                    `json:"lecture_id"`
+	TermID       string                 `json:"term_id"`
+	Status       model.EnrollmentStatus `json:"status"`
+	WaitlistedAt time.Time              `json:"waitlisted_at"`
+	EnrolledAt   time.Time              `json:"enrolled_at"`
+	DroppedAt    time.Time              `json:"dropped_at"`
+	WithdrawnAt  time.Time              `json:"withdrawn_at"`
 }
 
 func (er enrollmentRecord) toModel() model.Enrollment {
 	return model.Enrollment{
-		ID:        er.ID,
-		StudentID: er.StudentID,
-		LectureID: er.LectureID,
+		ID:           er.ID,
+		StudentID:    er.StudentID,
+		LectureID:    er.LectureID,
+		TermID:       er.TermID,
+		Status:       er.Status,
+		WaitlistedAt: er.WaitlistedAt,
+		EnrolledAt:   er.EnrolledAt,
+		DroppedAt:    er.DroppedAt,
+		WithdrawnAt:  er.WithdrawnAt,
 	}
 }
 
+func toEnrollments(records []enrollmentRecord) []model.Enrollment {
+	list := make([]model.Enrollment, 0, len(records))
+	for _, record := range records {
+		list = append(list, record.toModel())
+	}
+	return list
+}
+
+// statusPayload 상태와 상태별 시각 컬럼 (거치지 않은 상태의 시각은 NULL)
+func statusPayload(enrollment model.Enrollment) map[string]interface{} {
+	nullable := func(at time.Time) interface{} {
+		if at.IsZero() {
+			return nil
+		}
+		return at
+	}
+	return map[string]interface{}{
+		"status":        enrollment.Status,
+		"waitlisted_at": nullable(enrollment.WaitlistedAt),
+		"enrolled_at":   nullable(enrollment.EnrolledAt),
+		"dropped_at":    nullable(enrollment.DroppedAt),
+		"withdrawn_at":  nullable(enrollment.WithdrawnAt),
+	}
+}
+
+func insertPayload(enrollment model.Enrollment) map[string]interface{} {
+	payload := statusPayload(enrollment)
+	payload["student_id"] = enrollment.StudentID
+	payload["lecture_id"] = enrollment.LectureID
+	payload["term_id"] = enrollment.TermID
+	return payload
+}
+
+func statusFilter(statuses []model.EnrollmentStatus) []string {
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+	return values
+}
+
 func NewEnrollmentRepository(client *supabase.Client) EnrollmentRepository {
 	return &enrollmentRepository{client: client}
 }
 
-func (r *enrollmentRepository) Create(enrollment model.Enrollment) (model.Enrollment, error) {
-	payload := map[string]interface{}{
-		"student_id": enrollment.StudentID,
-		"lecture_id": enrollment.LectureID,
+func (r *enrollmentRepository) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Enrollment{}, err
 	}
 
 	var inserted []enrollmentRecord
 	_, err := r.client.From("enrollments").
-		Insert(payload, false, "", "representation", "").
+		Insert(insertPayload(enrollment), false, "", "representation", "").
 		ExecuteTo(&inserted)
 	if err != nil {
 		return model.Enrollment{}, err
 	}
 
-	return inserted[0].toModel(), nil
+	created := inserted[0]
+	r.undo.record(func() error {
+		_, _, err := r.client.From("enrollments").
+			Delete("", "").
+			Eq("id", strconv.Itoa(created.ID)).
+			Execute()
+		return err
+	})
+	return created.toModel(), nil
 }
 
-func (r *enrollmentRepository) FindByStudent(studentID int) ([]model.Enrollment, error) {
-	var records []enrollmentRecord
-	_, err := r.client.From("enrollments").
+func (r *enrollmentRepository) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := r.client.From("enrollments").
 		Select("*", "", false).
-		Eq("student_id", strconv.Itoa(studentID)).
+		Eq("student_id", strconv.Itoa(studentID))
+	if len(statuses) > 0 {
+		builder = builder.In("status", statusFilter(statuses))
+	}
+
+	var records []enrollmentRecord
+	_, err := builder.
 		Order("id", &postgrest.OrderOpts{Ascending: false}).
 		ExecuteTo(&records)
 	if err != nil {
 		return nil, err
 	}
+	return toEnrollments(records), nil
+}
 
-	list := make([]model.Enrollment, 0, len(records))
-	for _, record := range records {
-		list = append(list, record.toModel())
+func (r *enrollmentRepository) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := r.client.From("enrollments").
+		Select("*", "", false).
+		Eq("lecture_id", strconv.Itoa(lectureID))
+	if len(statuses) > 0 {
+		builder = builder.In("status", statusFilter(statuses))
+	}
+
+	var records []enrollmentRecord
+	_, err := builder.
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&records)
+	if err != nil {
+		return nil, err
 	}
-	return list, nil
+	return toEnrollments(records), nil
 }
 
-func (r *enrollmentRepository) FindLecturesByStudent(studentID int) ([]model.Lecture, error) {
-	var lectures []model.Lecture
-	_, err := r.client.From("lectures").
-		Select("*, enrollments!inner(student_id)", "", false).
+func (r *enrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	builder := r.client.From("lectures").
+		Select("*, enrollments!inner(student_id, status)", "", false).
 		Eq("enrollments.student_id", strconv.Itoa(studentID)).
+		Eq("enrollments.status", string(model.EnrollmentStatusEnrolled))
+	if termID != "" {
+		builder = builder.Eq("term_id", termID)
+	}
+
+	var lectures []model.Lecture
+	_, err := builder.
 		Order("id", &postgrest.OrderOpts{Ascending: true}).
 		ExecuteTo(&lectures)
 	return lectures, err
 }
 
-func (r *enrollmentRepository) CountByLectureID(lectureID int) (int, error) {
+func (r *enrollmentRepository) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	var records []enrollmentRecord
 	_, err := r.client.From("enrollments").
 		Select("*", "", false).
 		Eq("lecture_id", strconv.Itoa(lectureID)).
+		Eq("status", string(model.EnrollmentStatusEnrolled)).
 		ExecuteTo(&records)
 	if err != nil {
 		return 0, err
@@ -95,11 +208,87 @@ func (r *enrollmentRepository) CountByLectureID(lectureID int) (int, error) {
 	return len(records), nil
 }
 
-func (r *enrollmentRepository) DeleteByStudentAndLecture(studentID, lectureID int) error {
-	_, _, err := r.client.From("enrollments").
-		Delete("", "").
-		Eq("student_id", strconv.Itoa(studentID)).
+func (r *enrollmentRepository) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var records []enrollmentRecord
+	_, err := r.client.From("enrollments").
+		Select("student_id", "", false).
 		Eq("lecture_id", strconv.Itoa(lectureID)).
+		Eq("status", string(model.EnrollmentStatusEnrolled)).
+		Order("student_id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIDs := make([]int, 0, len(records))
+	for _, record := range records {
+		studentIDs = append(studentIDs, record.StudentID)
+	}
+	return studentIDs, nil
+}
+
+func (r *enrollmentRepository) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var before []enrollmentRecord
+	if r.undo != nil {
+		_, err := r.client.From("enrollments").
+			Select("*", "", false).
+			Eq("id", strconv.Itoa(enrollment.ID)).
+			ExecuteTo(&before)
+		if err != nil {
+			return err
+		}
+	}
+
+	var updated []enrollmentRecord
+	_, err := r.client.From("enrollments").
+		Update(statusPayload(enrollment), "representation", "").
+		Eq("id", strconv.Itoa(enrollment.ID)).
+		Eq("status", string(previous)).
+		ExecuteTo(&updated)
+	if err != nil {
+		return err
+	}
+	if len(updated) == 0 {
+		return exception.ErrEnrollmentNotFound
+	}
+
+	r.undo.record(func() error {
+		if len(before) == 0 {
+			return nil
+		}
+		_, _, err := r.client.From("enrollments").
+			Update(statusPayload(before[0].toModel()), "minimal", "").
+			Eq("id", strconv.Itoa(enrollment.ID)).
+			Execute()
+		return err
+	})
+	return nil
+}
+
+// restoreEnrollments 보상 작업으로 삭제된 수강신청을 원래 ID와 상태로 다시 등록
+// 대기 순서는 ID 오름차순이므로 새 ID를 받으면 대기 중인 학생이 맨 뒤로 밀림
+func restoreEnrollments(client *supabase.Client, records []enrollmentRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	payload := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		row := insertPayload(record.toModel())
+		row["id"] = record.ID
+		payload = append(payload, row)
+	}
+
+	_, _, err := client.From("enrollments").
+		Insert(payload, false, "", "minimal", "").
 		Execute()
 	return err
 }
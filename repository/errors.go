@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"fmt"
+	"golang-course-registration/common/exception"
+)
+
+// ConflictError 강좌를 읽은 뒤 다른 요청이 먼저 변경하여 갱신이 거부됨
+type ConflictError struct {
+	LectureID       int
+	ExpectedVersion int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s (강좌번호 %d, 버전 %d)", exception.ErrLectureVersionConflict, e.LectureID, e.ExpectedVersion)
+}
+
+// Unwrap errors.Is(err, exception.ErrLectureVersionConflict)와 컨트롤러의 상태 코드 변환에 사용
+func (e *ConflictError) Unwrap() error {
+	return exception.ErrLectureVersionConflict
+}
+
+// isUniqueViolation PostgREST가 반환한 고유 제약 조건 위반(23505) 여부
+func isUniqueViolation(err error) bool {
+	match := postgrestErrorPattern.FindStringSubmatch(err.Error())
+	return match != nil && match[1] == "23505"
+}
+
+// isForeignKeyViolation PostgREST가 반환한 외래키 제약 조건 위반(23503) 여부
+func isForeignKeyViolation(err error) bool {
+	match := postgrestErrorPattern.FindStringSubmatch(err.Error())
+	return match != nil && match[1] == "23503"
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"golang-course-registration/model"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheStats 강좌 캐시 적중/실패 횟수와 현재 보관 중인 항목 수
+type CacheStats struct {
+	Enabled bool  `json:"enabled"`
+	Hits    int64 `json:"hits"`
+	Misses  int64 `json:"misses"`
+	Entries int   `json:"entries"`
+}
+
+// maxCachedPages 조회 조건 조합이 무한히 쌓이지 않도록 목록 페이지 항목 수 제한
+const maxCachedPages = 256
+
+// lectureNameKey 강좌명은 학기 안에서만 고유하므로 학기와 함께 조회 키로 사용
+type lectureNameKey struct {
+	termID string
+	name   string
+}
+
+type cacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// LectureCache 강좌 조회 결과를 TTL 동안 보관하는 캐시
+// 저장소 데코레이터와 작업 단위가 함께 사용하며, nil이면 캐시를 사용하지 않음
+type LectureCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu     sync.Mutex
+	byID   map[int]cacheEntry[model.Lecture]
+	byName map[lectureNameKey]cacheEntry[model.Lecture]
+	all    *cacheEntry[[]model.Lecture]
+	pages  map[LectureQuery]cacheEntry[LecturePage]
+	// gen 무효화할 때마다 증가, 조회 도중 무효화되었다면 오래된 결과를 저장하지 않음
+	gen uint64
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func NewLectureCache(ttl time.Duration) *LectureCache {
+	return &LectureCache{
+		ttl:    ttl,
+		now:    time.Now,
+		byID:   make(map[int]cacheEntry[model.Lecture]),
+		byName: make(map[lectureNameKey]cacheEntry[model.Lecture]),
+		pages:  make(map[LectureQuery]cacheEntry[LecturePage]),
+	}
+}
+
+// Stats 적중/실패 횟수와 만료되지 않은 항목 수
+func (c *LectureCache) Stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	entries := 0
+	for _, entry := range c.byID {
+		if now.Before(entry.expiresAt) {
+			entries++
+		}
+	}
+	for _, entry := range c.byName {
+		if now.Before(entry.expiresAt) {
+			entries++
+		}
+	}
+	for _, entry := range c.pages {
+		if now.Before(entry.expiresAt) {
+			entries++
+		}
+	}
+	if c.all != nil && now.Before(c.all.expiresAt) {
+		entries++
+	}
+
+	return CacheStats{
+		Enabled: true,
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: entries,
+	}
+}
+
+// InvalidateAll 모든 항목 제거
+func (c *LectureCache) InvalidateAll() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byID = make(map[int]cacheEntry[model.Lecture])
+	c.byName = make(map[lectureNameKey]cacheEntry[model.Lecture])
+	c.pages = make(map[LectureQuery]cacheEntry[LecturePage])
+	c.all = nil
+	c.gen++
+}
+
+// InvalidateLecture 강좌 하나와 그 강좌를 포함할 수 있는 목록 항목 제거
+func (c *LectureCache) InvalidateLecture(lectureID int) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.byID, lectureID)
+	for key, entry := range c.byName {
+		if entry.value.ID == lectureID {
+			delete(c.byName, key)
+		}
+	}
+	c.pages = make(map[LectureQuery]cacheEntry[LecturePage])
+	c.all = nil
+	c.gen++
+}
+
+// generation 저장소 조회 전에 읽어 두었다가 put에 전달
+func (c *LectureCache) generation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gen
+}
+
+func (c *LectureCache) getByID(id int) (model.Lecture, bool) {
+	c.mu.Lock()
+	entry, ok := c.byID[id]
+	c.mu.Unlock()
+	return entry.value, c.record(ok && c.now().Before(entry.expiresAt))
+}
+
+func (c *LectureCache) putByID(gen uint64, lecture model.Lecture) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	c.byID[lecture.ID] = cacheEntry[model.Lecture]{lecture, c.now().Add(c.ttl)}
+}
+
+func (c *LectureCache) getByName(termID, name string) (model.Lecture, bool) {
+	c.mu.Lock()
+	entry, ok := c.byName[lectureNameKey{termID, name}]
+	c.mu.Unlock()
+	return entry.value, c.record(ok && c.now().Before(entry.expiresAt))
+}
+
+func (c *LectureCache) putByName(gen uint64, lecture model.Lecture) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	c.byName[lectureNameKey{lecture.TermID, lecture.Name}] = cacheEntry[model.Lecture]{lecture, c.now().Add(c.ttl)}
+}
+
+func (c *LectureCache) getAll() ([]model.Lecture, bool) {
+	c.mu.Lock()
+	entry := c.all
+	c.mu.Unlock()
+	if !c.record(entry != nil && c.now().Before(entry.expiresAt)) {
+		return nil, false
+	}
+	return append([]model.Lecture(nil), entry.value...), true
+}
+
+func (c *LectureCache) putAll(gen uint64, lectures []model.Lecture) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	c.all = &cacheEntry[[]model.Lecture]{append([]model.Lecture(nil), lectures...), c.now().Add(c.ttl)}
+}
+
+func (c *LectureCache) getPage(query LectureQuery) (LecturePage, bool) {
+	c.mu.Lock()
+	entry, ok := c.pages[query]
+	c.mu.Unlock()
+	if !c.record(ok && c.now().Before(entry.expiresAt)) {
+		return LecturePage{}, false
+	}
+	page := entry.value
+	page.Lectures = append([]model.Lecture(nil), page.Lectures...)
+	return page, true
+}
+
+func (c *LectureCache) putPage(gen uint64, query LectureQuery, page LecturePage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if len(c.pages) >= maxCachedPages {
+		c.pages = make(map[LectureQuery]cacheEntry[LecturePage])
+	}
+	page.Lectures = append([]model.Lecture(nil), page.Lectures...)
+	c.pages[query] = cacheEntry[LecturePage]{page, c.now().Add(c.ttl)}
+}
+
+// record 적중/실패 횟수를 세고 적중 여부를 그대로 반환
+func (c *LectureCache) record(hit bool) bool {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return hit
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"golang-course-registration/model"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// 강좌 목록 정렬 기준
+const (
+	LectureSortID        = "id"
+	LectureSortName      = "name"
+	LectureSortCredit    = "credit"
+	LectureSortCapacity  = "capacity"
+	LectureSortStartTime = "start_time"
+)
+
+// LectureQuery 강좌 목록 조회 조건 (0값 필드는 조건에서 제외)
+type LectureQuery struct {
+	TermID     string
+	RoomID     string    // 이 강의실에 배정된 강좌
+	Day        model.Day // 이 요일에 수업 시간이 하나라도 있는 강좌
+	Credit     int
+	OpenOnly   bool            // 정원이 남은 강좌만
+	StartFrom  model.TimeOfDay // 모든 수업 시간의 시작 시각 하한
+	EndUntil   model.TimeOfDay // 모든 수업 시간의 종료 시각 상한
+	Name       string          // 강좌명 부분 일치
+	Sort       string
+	Descending bool
+	Offset     int
+	Limit      int // 0이면 전체
+}
+
+// LecturePage 조회 조건에 맞는 강좌 중 한 페이지와 전체 개수
+type LecturePage struct {
+	Lectures []model.Lecture
+	Total    int
+}
+
+// IsValidLectureSort 지원하는 정렬 기준인지 확인
+func IsValidLectureSort(key string) bool {
+	switch key {
+	case LectureSortID, LectureSortName, LectureSortCredit, LectureSortCapacity, LectureSortStartTime:
+		return true
+	default:
+		return false
+	}
+}
+
+// sortKey 정렬 기준이 비어 있거나 지원하지 않으면 강좌번호
+func (q LectureQuery) sortKey() string {
+	if !IsValidLectureSort(q.Sort) {
+		return LectureSortID
+	}
+	return q.Sort
+}
+
+func (q LectureQuery) matches(lecture model.Lecture) bool {
+	if q.TermID != "" && lecture.TermID != q.TermID {
+		return false
+	}
+	if q.RoomID != "" && lecture.RoomID != q.RoomID {
+		return false
+	}
+	if q.Day != "" && !slices.ContainsFunc(lecture.Slots, func(slot model.MeetingSlot) bool { return slot.Day == q.Day }) {
+		return false
+	}
+	if q.Credit != 0 && lecture.Credit != q.Credit {
+		return false
+	}
+	if q.OpenOnly && lecture.IsFull() {
+		return false
+	}
+	for _, slot := range lecture.Slots {
+		if q.StartFrom != 0 && slot.StartTime < q.StartFrom {
+			return false
+		}
+		if q.EndUntil != 0 && slot.EndTime > q.EndUntil {
+			return false
+		}
+	}
+	if q.Name != "" && !strings.Contains(strings.ToLower(lecture.Name), strings.ToLower(q.Name)) {
+		return false
+	}
+	return true
+}
+
+// less 정렬 기준이 같으면 강좌번호 오름차순
+func (q LectureQuery) less(a, b model.Lecture) bool {
+	var cmp int
+	switch q.sortKey() {
+	case LectureSortName:
+		cmp = strings.Compare(a.Name, b.Name)
+	case LectureSortCredit:
+		cmp = a.Credit - b.Credit
+	case LectureSortCapacity:
+		cmp = a.Capacity - b.Capacity
+	case LectureSortStartTime:
+		cmp = int(a.EarliestStartTime() - b.EarliestStartTime())
+	default:
+		cmp = a.ID - b.ID
+	}
+
+	if cmp == 0 {
+		return a.ID < b.ID
+	}
+	if q.Descending {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// applyLectureQuery 저장소에서 조건을 표현할 수 없을 때 메모리에서 필터링, 정렬, 페이지 분할
+func applyLectureQuery(lectures []model.Lecture, query LectureQuery) LecturePage {
+	filtered := make([]model.Lecture, 0, len(lectures))
+	for _, lecture := range lectures {
+		if query.matches(lecture) {
+			filtered = append(filtered, lecture)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return query.less(filtered[i], filtered[j])
+	})
+
+	total := len(filtered)
+	start := min(query.Offset, total)
+	end := total
+	if query.Limit > 0 {
+		end = min(start+query.Limit, total)
+	}
+
+	return LecturePage{Lectures: filtered[start:end], Total: total}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"golang-course-registration/model"
+	"testing"
+)
+
+func TestLectureRepositoryFindPage(t *testing.T) {
+	backends := []struct {
+		name    string
+		newRepo func(t *testing.T) LectureRepository
+	}{
+		{"memory", func(t *testing.T) LectureRepository { return NewMemoryLectureRepository(NewMemoryStore()) }},
+		{"sqlite", func(t *testing.T) LectureRepository { return NewSQLiteLectureRepository(newTestSQLiteDB(t)) }},
+	}
+	testCases := []struct {
+		name          string
+		query         LectureQuery
+		expectedIDs   []int
+		expectedTotal int
+	}{
+		{"요일 필터", LectureQuery{Day: model.Monday}, []int{1001, 1003, 1005}, 3},
+		{"정원이 남은 강좌만", LectureQuery{Day: model.Monday, OpenOnly: true}, []int{1003, 1005}, 2},
+		{"학점 필터 + 강좌명 내림차순", LectureQuery{Credit: 3, Sort: LectureSortName, Descending: true}, []int{1005, 1002, 1001}, 3},
+		{"강좌명 부분 일치 (대소문자 무시)", LectureQuery{Name: "data"}, []int{1003}, 1},
+		{"두 번째 수업 시간의 요일", LectureQuery{Day: model.Friday}, []int{1004}, 1},
+		{"시간 범위 (모든 수업 시간이 범위 안)", LectureQuery{StartFrom: mustTime(t, "10:00"), EndUntil: mustTime(t, "15:00")}, []int{1004, 1005}, 2},
+		{"가장 이른 시작 시간 순", LectureQuery{Sort: LectureSortStartTime}, []int{1001, 1004, 1005, 1002, 1003}, 5},
+		{"정렬 후 페이지 분할", LectureQuery{Sort: LectureSortCapacity, Offset: 2, Limit: 2}, []int{1004, 1001}, 5},
+		{"와일드카드 문자는 그대로 검색", LectureQuery{Name: "%"}, []int{}, 0},
+	}
+
+	for _, backend := range backends {
+		for _, tc := range testCases {
+			t.Run(backend.name+" "+tc.name, func(t *testing.T) {
+				// given
+				repo := backend.newRepo(t)
+				seedLecturesForQuery(t, repo)
+
+				// when
+				page, err := repo.FindPage(t.Context(), tc.query)
+
+				// then
+				if err != nil || page.Total != tc.expectedTotal || !sameLectureIDs(page.Lectures, tc.expectedIDs) {
+					t.Errorf("기대 : %v (전체 %d), 결과 : %v (전체 %d) %v", tc.expectedIDs, tc.expectedTotal, page.Lectures, page.Total, err)
+				}
+			})
+		}
+	}
+}
+
+func seedLecturesForQuery(t *testing.T, repo LectureRepository) {
+	full, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+	full.CurrentEnrollment = 30
+	system, _ := model.NewLectureWithSlots(1002, "운영체제", 20, 3, []model.MeetingSlotInput{
+		{Day: model.Tuesday, StartTime: "13:00", EndTime: "14:30"},
+		{Day: model.Thursday, StartTime: "16:00", EndTime: "17:30"},
+	})
+	lab, _ := model.NewLecture(1003, "Database Lab", 10, 1, model.Monday, "15:00", "17:00")
+	network, _ := model.NewLectureWithSlots(1004, "네트워크", 25, 2, []model.MeetingSlotInput{
+		{Day: model.Friday, StartTime: "13:00", EndTime: "14:30"},
+		{Day: model.Wednesday, StartTime: "10:00", EndTime: "11:30"},
+	})
+	ds, _ := model.NewLecture(1005, "자료구조", 30, 3, model.Monday, "11:00", "12:30")
+	for _, lecture := range []*model.Lecture{full, system, lab, network, ds} {
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+	}
+}
+
+func mustTime(t *testing.T, value string) model.TimeOfDay {
+	t.Helper()
+	timeOfDay, err := model.ParseTimeOfDay(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return timeOfDay
+}
+
+func sameLectureIDs(lectures []model.Lecture, ids []int) bool {
+	if len(lectures) != len(ids) {
+		return false
+	}
+	for i, lecture := range lectures {
+		if lecture.ID != ids[i] {
+			return false
+		}
+	}
+	return true
+}
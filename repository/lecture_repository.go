@@ -1,33 +1,45 @@
 package repository
 
 import (
-	"errors"
+	"context"
 	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
 	"strconv"
+	"strings"
 
 	"github.com/supabase-community/postgrest-go"
 	"github.com/supabase-community/supabase-go"
 )
 
 type LectureRepository interface {
-	FindAll() ([]model.Lecture, error)
-	FindByID(id int) (model.Lecture, error)
-	FindByName(name string) (model.Lecture, error)
-	Create(lecture model.Lecture) (model.Lecture, error)
-	Delete(id int) error
-	UpdateCurrentEnrollment(lectureID int, currentEnrollment int) error
+	FindAll(ctx context.Context) ([]model.Lecture, error)
+	// FindPage 조건에 맞는 강좌를 정렬하여 한 페이지만 조회하고 전체 개수를 함께 반환
+	FindPage(ctx context.Context, query LectureQuery) (LecturePage, error)
+	FindByID(ctx context.Context, id int) (model.Lecture, error)
+	// FindByName 학기 안에서 강좌명으로 조회 (강좌명은 학기별로 고유)
+	FindByName(ctx context.Context, termID, name string) (model.Lecture, error)
+	Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error)
+	Delete(ctx context.Context, id int) error
+	// UpdateCurrentEnrollment 버전이 expectedVersion과 같을 때만 갱신하고 버전을 올림, 아니면 *ConflictError
+	UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error
+	// Update 강좌명, 정원, 학점, 수업 시간, 강의실, 폐강 예정 표시를 버전이 expectedVersion과 같을 때만 변경하고 버전을 올림, 아니면 *ConflictError
+	Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error)
 }
 
 type lectureRepository struct {
 	client *supabase.Client
+	undo   *compensationLog
 }
 
 func NewLectureRepository(client *supabase.Client) LectureRepository {
 	return &lectureRepository{client: client}
 }
 
-func (r *lectureRepository) FindAll() ([]model.Lecture, error) {
+func (r *lectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	var result []model.Lecture
 	_, err := r.client.From("lectures").
 		Select("*", "", false).
@@ -36,7 +48,66 @@ func (r *lectureRepository) FindAll() ([]model.Lecture, error) {
 	return result, err
 }
 
-func (r *lectureRepository) FindByID(id int) (model.Lecture, error) {
+func (r *lectureRepository) FindPage(ctx context.Context, query LectureQuery) (LecturePage, error) {
+	if err := ctx.Err(); err != nil {
+		return LecturePage{}, err
+	}
+
+	// PostgREST는 컬럼 간 비교(current_enrollment < capacity)와 jsonb 배열 원소의 범위 비교를 지원하지 않으므로
+	// 정원/시간 조건이나 시작 시간 정렬이 있으면 나머지 조건으로 거른 뒤 메모리에서 페이지를 나눔
+	if query.OpenOnly || query.StartFrom != 0 || query.EndUntil != 0 || query.sortKey() == LectureSortStartTime {
+		var result []model.Lecture
+		_, err := r.filterLectures(query, "").ExecuteTo(&result)
+		if err != nil {
+			return LecturePage{}, err
+		}
+		return applyLectureQuery(result, query), nil
+	}
+
+	builder := r.filterLectures(query, "exact").
+		Order(query.sortKey(), &postgrest.OrderOpts{Ascending: !query.Descending})
+	if query.sortKey() != LectureSortID {
+		builder = builder.Order("id", &postgrest.OrderOpts{Ascending: true})
+	}
+	if query.Limit > 0 {
+		builder = builder.Range(query.Offset, query.Offset+query.Limit-1, "")
+	}
+
+	var result []model.Lecture
+	count, err := builder.ExecuteTo(&result)
+	if err != nil {
+		return LecturePage{}, err
+	}
+	return LecturePage{Lectures: result, Total: int(count)}, nil
+}
+
+// filterLectures 정원/시간 조건을 제외한 조회 조건을 PostgREST 필터로 변환
+// 요일은 수업 시간 목록(jsonb)이 해당 요일의 원소를 포함하는지(cs)로 검사
+func (r *lectureRepository) filterLectures(query LectureQuery, count string) *postgrest.FilterBuilder {
+	builder := r.client.From("lectures").Select("*", count, false)
+	if query.TermID != "" {
+		builder = builder.Eq("term_id", query.TermID)
+	}
+	if query.RoomID != "" {
+		builder = builder.Eq("room_id", query.RoomID)
+	}
+	if query.Day != "" {
+		builder = builder.Filter("slots", "cs", `[{"day":"`+string(query.Day)+`"}]`)
+	}
+	if query.Credit != 0 {
+		builder = builder.Eq("credit", strconv.Itoa(query.Credit))
+	}
+	if query.Name != "" {
+		builder = builder.Ilike("name", "*"+query.Name+"*")
+	}
+	return builder
+}
+
+func (r *lectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Lecture{}, err
+	}
+
 	var result []model.Lecture
 	_, err := r.client.From("lectures").
 		Select("*", "", false).
@@ -48,16 +119,21 @@ func (r *lectureRepository) FindByID(id int) (model.Lecture, error) {
 	}
 
 	if len(result) == 0 {
-		return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+		return model.Lecture{}, exception.ErrLectureNotFound
 	}
 
 	return result[0], nil
 }
 
-func (r *lectureRepository) FindByName(name string) (model.Lecture, error) {
+func (r *lectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Lecture{}, err
+	}
+
 	var result []model.Lecture
 	_, err := r.client.From("lectures").
 		Select("*", "", false).
+		Eq("term_id", termID).
 		Eq("name", name).
 		Limit(1, "").
 		ExecuteTo(&result)
@@ -67,44 +143,187 @@ func (r *lectureRepository) FindByName(name string) (model.Lecture, error) {
 	}
 
 	if len(result) == 0 {
-		return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+		return model.Lecture{}, exception.ErrLectureNotFound
 	}
 
 	return result[0], nil
 }
 
-func (r *lectureRepository) Create(lecture model.Lecture) (model.Lecture, error) {
+func (r *lectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Lecture{}, err
+	}
+
 	var result []model.Lecture
 	_, err := r.client.From("lectures").
 		Insert(lecture, false, "", "representation", "").
 		ExecuteTo(&result)
 	if err != nil {
-		return model.Lecture{}, err
+		return model.Lecture{}, lectureConstraintError(err)
 	}
 
 	if len(result) == 0 {
-		return model.Lecture{}, errors.New(exception.ErrLectureListIsEmpty)
+		return model.Lecture{}, exception.ErrLectureListIsEmpty
 	}
 
+	r.undo.record(func() error {
+		return (&lectureRepository{client: r.client}).Delete(context.Background(), lecture.ID)
+	})
 	return result[0], nil
 }
 
-func (r *lectureRepository) Delete(id int) error {
+// lectureConstraintError 서비스의 사전 검사를 동시 요청이 통과해 제약 조건에 걸린 경우 메모리 저장소와 같은 도메인 에러로 변환
+// 고유 제약은 강좌명(lectures_term_id_name_key) 외에는 강좌번호, 외래키는 강의실(lectures_room_id_fkey) 외에는 학기
+func lectureConstraintError(err error) error {
+	switch {
+	case isUniqueViolation(err) && strings.Contains(err.Error(), "lectures_term_id_name_key"):
+		return exception.ErrLectureNameDuplicate
+	case isUniqueViolation(err):
+		return exception.ErrLectureIDDuplicate
+	case isForeignKeyViolation(err) && strings.Contains(err.Error(), "lectures_room_id_fkey"):
+		return exception.ErrRoomNotFound
+	case isForeignKeyViolation(err):
+		return exception.ErrTermNotFound
+	}
+	return err
+}
+
+func (r *lectureRepository) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var deleted model.Lecture
+	var cascaded []enrollmentRecord
+	if r.undo != nil {
+		var err error
+		if deleted, err = r.FindByID(ctx, id); err != nil {
+			return err
+		}
+		_, err = r.client.From("enrollments").
+			Select("*", "", false).
+			Eq("lecture_id", strconv.Itoa(id)).
+			ExecuteTo(&cascaded)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, _, err := r.client.From("lectures").
 		Delete("", "").
 		Eq("id", strconv.Itoa(id)).
 		Execute()
-	return err
+	if err != nil {
+		return err
+	}
+
+	r.undo.record(func() error {
+		if _, err := (&lectureRepository{client: r.client}).Create(context.Background(), deleted); err != nil {
+			return err
+		}
+		return restoreEnrollments(r.client, cascaded)
+	})
+	return nil
 }
 
-func (r *lectureRepository) UpdateCurrentEnrollment(lectureID int, currentEnrollment int) error {
+func (r *lectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var previous model.Lecture
+	if r.undo != nil {
+		var err error
+		if previous, err = r.FindByID(ctx, lectureID); err != nil {
+			return err
+		}
+	}
+
 	updateData := map[string]interface{}{
 		"current_enrollment": currentEnrollment,
+		"version":            expectedVersion + 1,
 	}
 
-	_, _, err := r.client.From("lectures").
-		Update(updateData, "", "").
+	var updated []model.Lecture
+	_, err := r.client.From("lectures").
+		Update(updateData, "representation", "").
 		Eq("id", strconv.Itoa(lectureID)).
-		Execute()
-	return err
+		Eq("version", strconv.Itoa(expectedVersion)).
+		ExecuteTo(&updated)
+	if err != nil {
+		return err
+	}
+
+	if len(updated) == 0 {
+		if _, err := r.FindByID(ctx, lectureID); err != nil {
+			return err
+		}
+		return &ConflictError{LectureID: lectureID, ExpectedVersion: expectedVersion}
+	}
+
+	r.undo.record(func() error {
+		return (&lectureRepository{client: r.client}).UpdateCurrentEnrollment(context.Background(), lectureID, previous.CurrentEnrollment, expectedVersion+1)
+	})
+	return nil
+}
+
+func (r *lectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Lecture{}, err
+	}
+
+	var previous model.Lecture
+	if r.undo != nil {
+		var err error
+		if previous, err = r.FindByID(ctx, lecture.ID); err != nil {
+			return model.Lecture{}, err
+		}
+	}
+
+	updateData := map[string]interface{}{
+		"name":         lecture.Name,
+		"capacity":     lecture.Capacity,
+		"credit":       lecture.Credit,
+		"slots":        lecture.Slots,
+		"room_id":      nullableString(lecture.RoomID),
+		"discontinued": lecture.Discontinued,
+		"version":      expectedVersion + 1,
+	}
+
+	var updated []model.Lecture
+	_, err := r.client.From("lectures").
+		Update(updateData, "representation", "").
+		Eq("id", strconv.Itoa(lecture.ID)).
+		Eq("version", strconv.Itoa(expectedVersion)).
+		ExecuteTo(&updated)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return model.Lecture{}, exception.ErrLectureNameDuplicate
+		}
+		if isForeignKeyViolation(err) {
+			return model.Lecture{}, exception.ErrRoomNotFound
+		}
+		return model.Lecture{}, err
+	}
+
+	if len(updated) == 0 {
+		if _, err := r.FindByID(ctx, lecture.ID); err != nil {
+			return model.Lecture{}, err
+		}
+		return model.Lecture{}, &ConflictError{LectureID: lecture.ID, ExpectedVersion: expectedVersion}
+	}
+
+	r.undo.record(func() error {
+		_, err := (&lectureRepository{client: r.client}).Update(context.Background(), previous, expectedVersion+1)
+		return err
+	})
+	return updated[0], nil
+}
+
+// nullableString 빈 문자열은 NULL로 저장 (강의실이 배정되지 않은 강좌)
+func nullableString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
 }
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"sort"
+)
+
+type memoryEnrollmentRepository struct {
+	db memoryDB
+}
+
+func NewMemoryEnrollmentRepository(store *MemoryStore) EnrollmentRepository {
+	return &memoryEnrollmentRepository{db: store}
+}
+
+func (r *memoryEnrollmentRepository) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.students[enrollment.StudentID]; !exists {
+			return exception.ErrStudentNotFound
+		}
+		if _, exists := t.lectures[enrollment.LectureID]; !exists {
+			return exception.ErrLectureNotFound
+		}
+
+		enrollment.ID = t.nextEnrollmentID
+		t.nextEnrollmentID++
+		t.enrollments[enrollment.ID] = enrollment
+		return nil
+	})
+	if err != nil {
+		return model.Enrollment{}, err
+	}
+	return enrollment, nil
+}
+
+func (r *memoryEnrollmentRepository) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	list := make([]model.Enrollment, 0)
+	r.db.read(func(t *memoryTables) {
+		for _, enrollment := range t.enrollments {
+			if enrollment.StudentID == studentID && enrollment.HasStatus(statuses...) {
+				list = append(list, enrollment)
+			}
+		}
+	})
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID > list[j].ID
+	})
+	return list, nil
+}
+
+func (r *memoryEnrollmentRepository) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	list := make([]model.Enrollment, 0)
+	r.db.read(func(t *memoryTables) {
+		for _, enrollment := range t.enrollments {
+			if enrollment.LectureID == lectureID && enrollment.HasStatus(statuses...) {
+				list = append(list, enrollment)
+			}
+		}
+	})
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+func (r *memoryEnrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
+	lectures := make([]model.Lecture, 0)
+	r.db.read(func(t *memoryTables) {
+		for _, enrollment := range t.enrollments {
+			if enrollment.StudentID != studentID || !enrollment.IsActive() {
+				continue
+			}
+			if lecture, exists := t.lectures[enrollment.LectureID]; exists && (termID == "" || lecture.TermID == termID) {
+				lectures = append(lectures, lecture)
+			}
+		}
+	})
+	sort.Slice(lectures, func(i, j int) bool {
+		return lectures[i].ID < lectures[j].ID
+	})
+	return lectures, nil
+}
+
+func (r *memoryEnrollmentRepository) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
+	count := 0
+	r.db.read(func(t *memoryTables) {
+		for _, enrollment := range t.enrollments {
+			if enrollment.LectureID == lectureID && enrollment.IsActive() {
+				count++
+			}
+		}
+	})
+	return count, nil
+}
+
+func (r *memoryEnrollmentRepository) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	studentIDs := make([]int, 0)
+	r.db.read(func(t *memoryTables) {
+		for _, enrollment := range t.enrollments {
+			if enrollment.LectureID == lectureID && enrollment.IsActive() {
+				studentIDs = append(studentIDs, enrollment.StudentID)
+			}
+		}
+	})
+	sort.Ints(studentIDs)
+	return studentIDs, nil
+}
+
+func (r *memoryEnrollmentRepository) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	return r.db.write(func(t *memoryTables) error {
+		stored, exists := t.enrollments[enrollment.ID]
+		if !exists || stored.Status != previous {
+			return exception.ErrEnrollmentNotFound
+		}
+
+		stored.Status = enrollment.Status
+		stored.WaitlistedAt = enrollment.WaitlistedAt
+		stored.EnrolledAt = enrollment.EnrolledAt
+		stored.DroppedAt = enrollment.DroppedAt
+		stored.WithdrawnAt = enrollment.WithdrawnAt
+		t.enrollments[enrollment.ID] = stored
+		return nil
+	})
+}
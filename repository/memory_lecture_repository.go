@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"slices"
+	"sort"
+)
+
+type memoryLectureRepository struct {
+	db memoryDB
+}
+
+func NewMemoryLectureRepository(store *MemoryStore) LectureRepository {
+	return &memoryLectureRepository{db: store}
+}
+
+func (r *memoryLectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
+	var result []model.Lecture
+	r.db.read(func(t *memoryTables) {
+		result = make([]model.Lecture, 0, len(t.lectures))
+		for _, lecture := range t.lectures {
+			result = append(result, lecture)
+		}
+	})
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (r *memoryLectureRepository) FindPage(ctx context.Context, query LectureQuery) (LecturePage, error) {
+	lectures, err := r.FindAll(ctx)
+	if err != nil {
+		return LecturePage{}, err
+	}
+	return applyLectureQuery(lectures, query), nil
+}
+
+func (r *memoryLectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
+	var lecture model.Lecture
+	var exists bool
+	r.db.read(func(t *memoryTables) {
+		lecture, exists = t.lectures[id]
+	})
+	if !exists {
+		return model.Lecture{}, exception.ErrLectureNotFound
+	}
+	return lecture, nil
+}
+
+func (r *memoryLectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
+	var found model.Lecture
+	var exists bool
+	r.db.read(func(t *memoryTables) {
+		for _, lecture := range t.lectures {
+			if lecture.TermID == termID && lecture.Name == name {
+				found, exists = lecture, true
+				return
+			}
+		}
+	})
+	if !exists {
+		return model.Lecture{}, exception.ErrLectureNotFound
+	}
+	return found, nil
+}
+
+func (r *memoryLectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.lectures[lecture.ID]; exists {
+			return exception.ErrLectureIDDuplicate
+		}
+		for _, existing := range t.lectures {
+			if existing.TermID == lecture.TermID && existing.Name == lecture.Name {
+				return exception.ErrLectureNameDuplicate
+			}
+		}
+		if err := t.checkRoomExists(lecture.RoomID); err != nil {
+			return err
+		}
+		// 수업 시간 목록은 호출자와 공유하지 않도록 복사
+		lecture.Slots = slices.Clone(lecture.Slots)
+		t.lectures[lecture.ID] = lecture
+		return nil
+	})
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	return lecture, nil
+}
+
+// Delete 강좌 삭제 및 관련 수강신청 연쇄 삭제
+func (r *memoryLectureRepository) Delete(ctx context.Context, id int) error {
+	return r.db.write(func(t *memoryTables) error {
+		delete(t.lectures, id)
+		for enrollmentID, enrollment := range t.enrollments {
+			if enrollment.LectureID == id {
+				delete(t.enrollments, enrollmentID)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *memoryLectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
+	return r.db.write(func(t *memoryTables) error {
+		lecture, exists := t.lectures[lectureID]
+		if !exists {
+			return exception.ErrLectureNotFound
+		}
+		if lecture.Version != expectedVersion {
+			return &ConflictError{LectureID: lectureID, ExpectedVersion: expectedVersion}
+		}
+		lecture.CurrentEnrollment = currentEnrollment
+		lecture.Version++
+		t.lectures[lectureID] = lecture
+		return nil
+	})
+}
+
+func (r *memoryLectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	var updated model.Lecture
+	err := r.db.write(func(t *memoryTables) error {
+		current, exists := t.lectures[lecture.ID]
+		if !exists {
+			return exception.ErrLectureNotFound
+		}
+		if current.Version != expectedVersion {
+			return &ConflictError{LectureID: lecture.ID, ExpectedVersion: expectedVersion}
+		}
+		for _, existing := range t.lectures {
+			if existing.ID != lecture.ID && existing.TermID == current.TermID && existing.Name == lecture.Name {
+				return exception.ErrLectureNameDuplicate
+			}
+		}
+		if err := t.checkRoomExists(lecture.RoomID); err != nil {
+			return err
+		}
+		current.Name = lecture.Name
+		current.Capacity = lecture.Capacity
+		current.Credit = lecture.Credit
+		current.Slots = slices.Clone(lecture.Slots)
+		current.RoomID = lecture.RoomID
+		current.Discontinued = lecture.Discontinued
+		current.Version++
+		t.lectures[lecture.ID] = current
+		updated = current
+		return nil
+	})
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	return updated, nil
+}
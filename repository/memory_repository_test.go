@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"testing"
+)
+
+func TestMemoryLectureRepository(t *testing.T) {
+	t.Run("전체 목록 조회 : 강좌번호 오름차순", func(t *testing.T) {
+		// given
+		store := NewMemoryStore()
+		repo := NewMemoryLectureRepository(store)
+		names := map[int]string{1003: "네트워크", 1001: "데이터베이스", 1002: "운영체제"}
+		for id, name := range names {
+			lecture, _ := model.NewLecture(id, name, 30, 3, model.Monday, "09:00", "10:30")
+			_, _ = repo.Create(t.Context(), *lecture)
+		}
+
+		// when
+		lectures, _ := repo.FindAll(t.Context())
+
+		// then
+		for i, expected := range []int{1001, 1002, 1003} {
+			if lectures[i].ID != expected {
+				t.Errorf("기대 : %d, 결과 : %d", expected, lectures[i].ID)
+			}
+		}
+	})
+
+	t.Run("예외 : 중복된 강좌번호", func(t *testing.T) {
+		// given
+		repo := NewMemoryLectureRepository(NewMemoryStore())
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		duplicate, _ := model.NewLecture(1001, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), *lecture)
+
+		// when
+		_, err := repo.Create(t.Context(), *duplicate)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureIDDuplicate) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureIDDuplicate, err)
+		}
+	})
+
+	t.Run("예외 : 중복된 강좌명", func(t *testing.T) {
+		// given
+		repo := NewMemoryLectureRepository(NewMemoryStore())
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		duplicate, _ := model.NewLecture(1002, "데이터베이스", 30, 3, model.Tuesday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), *lecture)
+
+		// when
+		_, err := repo.Create(t.Context(), *duplicate)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureNameDuplicate) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNameDuplicate, err)
+		}
+	})
+
+	t.Run("강좌 삭제 시 수강신청 연쇄 삭제", func(t *testing.T) {
+		// given
+		store := NewMemoryStore()
+		lectureRepo := NewMemoryLectureRepository(store)
+		enrollmentRepo := NewMemoryEnrollmentRepository(store)
+		studentRepo := NewMemoryStudentRepository(store)
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = lectureRepo.Create(t.Context(), *lecture)
+		_, _ = studentRepo.Create(t.Context(), model.Student{ID: 2001})
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, Status: model.EnrollmentStatusEnrolled})
+
+		// when
+		_ = lectureRepo.Delete(t.Context(), 1001)
+
+		// then
+		count, _ := enrollmentRepo.CountByLectureID(t.Context(), 1001)
+		if count != 0 {
+			t.Errorf("기대 : 0, 결과 : %d", count)
+		}
+	})
+
+	t.Run("수강 인원 갱신 시 버전 증가", func(t *testing.T) {
+		// given
+		repo := NewMemoryLectureRepository(NewMemoryStore())
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), *lecture)
+
+		// when
+		err := repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// then
+		updated, _ := repo.FindByID(t.Context(), 1001)
+		if err != nil || updated.CurrentEnrollment != 1 || updated.Version != 1 {
+			t.Errorf("기대 : (1, 버전 1), 결과 : (%d, 버전 %d) %v", updated.CurrentEnrollment, updated.Version, err)
+		}
+	})
+
+	t.Run("예외 : 버전 충돌", func(t *testing.T) {
+		// given
+		repo := NewMemoryLectureRepository(NewMemoryStore())
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), *lecture)
+		_ = repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// when
+		err := repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// then
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureVersionConflict, err)
+		}
+	})
+}
+
+func TestMemoryEnrollmentRepository(t *testing.T) {
+	t.Run("학생별 수강 강좌 조회", func(t *testing.T) {
+		// given
+		store := NewMemoryStore()
+		lectureRepo := NewMemoryLectureRepository(store)
+		enrollmentRepo := NewMemoryEnrollmentRepository(store)
+		_, _ = NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 2001})
+		lecture1, _ := model.NewLecture(1002, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		lecture2, _ := model.NewLecture(1001, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+		_, _ = lectureRepo.Create(t.Context(), *lecture1)
+		_, _ = lectureRepo.Create(t.Context(), *lecture2)
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1002, Status: model.EnrollmentStatusEnrolled})
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, Status: model.EnrollmentStatusEnrolled})
+
+		// when
+		lectures, _ := enrollmentRepo.FindLecturesByStudent(t.Context(), 2001, "")
+
+		// then
+		if len(lectures) != 2 || lectures[0].ID != 1001 {
+			t.Errorf("기대 : 2개 (첫 강좌 1001), 결과 : %v", lectures)
+		}
+	})
+
+	t.Run("예외 : 존재하지 않는 강좌", func(t *testing.T) {
+		// given
+		store := NewMemoryStore()
+		_, _ = NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 2001})
+		enrollmentRepo := NewMemoryEnrollmentRepository(store)
+
+		// when
+		_, err := enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, Status: model.EnrollmentStatusEnrolled})
+
+		// then
+		if !errors.Is(err, exception.ErrLectureNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
+		}
+	})
+
+	t.Run("예외 : 존재하지 않는 수강신청 상태 변경", func(t *testing.T) {
+		// given
+		enrollmentRepo := NewMemoryEnrollmentRepository(NewMemoryStore())
+
+		// when
+		err := enrollmentRepo.UpdateStatus(t.Context(), model.Enrollment{ID: 1, Status: model.EnrollmentStatusDropped}, model.EnrollmentStatusEnrolled)
+
+		// then
+		if !errors.Is(err, exception.ErrEnrollmentNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentNotFound, err)
+		}
+	})
+}
+
+func TestMemoryStudentRepository(t *testing.T) {
+	t.Run("예외 : 중복된 학번", func(t *testing.T) {
+		// given
+		repo := NewMemoryStudentRepository(NewMemoryStore())
+		_, _ = repo.Create(t.Context(), model.Student{ID: 2001})
+
+		// when
+		_, err := repo.Create(t.Context(), model.Student{ID: 2001})
+
+		// then
+		if !errors.Is(err, exception.ErrStudentIDDuplicate) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrStudentIDDuplicate, err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"sort"
+)
+
+type memoryRoomRepository struct {
+	db memoryDB
+}
+
+func NewMemoryRoomRepository(store *MemoryStore) RoomRepository {
+	return &memoryRoomRepository{db: store}
+}
+
+func (r *memoryRoomRepository) Create(ctx context.Context, room model.Room) (model.Room, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.rooms[room.ID]; exists {
+			return exception.ErrRoomDuplicate
+		}
+		t.rooms[room.ID] = room
+		return nil
+	})
+	if err != nil {
+		return model.Room{}, err
+	}
+	return room, nil
+}
+
+func (r *memoryRoomRepository) FindByID(ctx context.Context, id string) (model.Room, error) {
+	var room model.Room
+	var exists bool
+	r.db.read(func(t *memoryTables) {
+		room, exists = t.rooms[id]
+	})
+	if !exists {
+		return model.Room{}, exception.ErrRoomNotFound
+	}
+	return room, nil
+}
+
+func (r *memoryRoomRepository) FindAll(ctx context.Context) ([]model.Room, error) {
+	var rooms []model.Room
+	r.db.read(func(t *memoryTables) {
+		rooms = make([]model.Room, 0, len(t.rooms))
+		for _, room := range t.rooms {
+			rooms = append(rooms, room)
+		}
+	})
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].ID < rooms[j].ID
+	})
+	return rooms, nil
+}
+
+func (r *memoryRoomRepository) Update(ctx context.Context, room model.Room) (model.Room, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.rooms[room.ID]; !exists {
+			return exception.ErrRoomNotFound
+		}
+		t.rooms[room.ID] = room
+		return nil
+	})
+	if err != nil {
+		return model.Room{}, err
+	}
+	return room, nil
+}
+
+// Delete 강좌가 배정된 강의실은 삭제하지 않음 (SQL 저장소의 외래키와 같은 제약)
+func (r *memoryRoomRepository) Delete(ctx context.Context, id string) error {
+	return r.db.write(func(t *memoryTables) error {
+		for _, lecture := range t.lectures {
+			if lecture.RoomID == id {
+				return exception.ErrRoomInUse
+			}
+		}
+		delete(t.rooms, id)
+		return nil
+	})
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"sync"
+)
+
+// MemoryStore 프로세스 메모리에 학기, 강의실, 강좌, 학생, 수강신청 데이터를 보관하는 저장소
+type MemoryStore struct {
+	mu     sync.RWMutex
+	tables *memoryTables
+}
+
+type memoryTables struct {
+	terms            map[string]model.Term
+	rooms            map[string]model.Room
+	lectures         map[int]model.Lecture
+	students         map[int]model.Student
+	enrollments      map[int]model.Enrollment
+	nextEnrollmentID int
+}
+
+// NewMemoryStore SQL 저장소의 마이그레이션처럼 기본 학기를 미리 등록
+func NewMemoryStore() *MemoryStore {
+	defaultTerm, _ := model.NewTerm(constants.DefaultTermID, constants.DefaultTermStartDate, constants.DefaultTermEndDate)
+	return &MemoryStore{
+		tables: &memoryTables{
+			terms:            map[string]model.Term{defaultTerm.ID: *defaultTerm},
+			rooms:            make(map[string]model.Room),
+			lectures:         make(map[int]model.Lecture),
+			students:         make(map[int]model.Student),
+			enrollments:      make(map[int]model.Enrollment),
+			nextEnrollmentID: 1,
+		},
+	}
+}
+
+// memoryDB 저장소가 테이블에 접근하는 방식 (잠금 또는 트랜잭션)
+type memoryDB interface {
+	read(fn func(t *memoryTables))
+	write(fn func(t *memoryTables) error) error
+}
+
+func (s *MemoryStore) read(fn func(t *memoryTables)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.tables)
+}
+
+func (s *MemoryStore) write(fn func(t *memoryTables) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.tables)
+}
+
+// memoryTx 트랜잭션 동안 저장소 잠금을 쥔 채 복사본 테이블에 작업
+type memoryTx struct {
+	tables *memoryTables
+}
+
+func (tx *memoryTx) read(fn func(t *memoryTables)) {
+	fn(tx.tables)
+}
+
+func (tx *memoryTx) write(fn func(t *memoryTables) error) error {
+	return fn(tx.tables)
+}
+
+func (t *memoryTables) clone() *memoryTables {
+	cloned := &memoryTables{
+		terms:            make(map[string]model.Term, len(t.terms)),
+		rooms:            make(map[string]model.Room, len(t.rooms)),
+		lectures:         make(map[int]model.Lecture, len(t.lectures)),
+		students:         make(map[int]model.Student, len(t.students)),
+		enrollments:      make(map[int]model.Enrollment, len(t.enrollments)),
+		nextEnrollmentID: t.nextEnrollmentID,
+	}
+	for id, term := range t.terms {
+		cloned.terms[id] = term
+	}
+	for id, room := range t.rooms {
+		cloned.rooms[id] = room
+	}
+	for id, lecture := range t.lectures {
+		cloned.lectures[id] = lecture
+	}
+	for id, student := range t.students {
+		cloned.students[id] = student
+	}
+	for id, enrollment := range t.enrollments {
+		cloned.enrollments[id] = enrollment
+	}
+	return cloned
+}
+
+// checkRoomExists 강좌에 배정할 강의실이 있는지 확인 (SQL 저장소의 외래키와 같은 제약, 비어 있으면 미배정)
+func (t *memoryTables) checkRoomExists(roomID string) error {
+	if roomID == "" {
+		return nil
+	}
+	if _, exists := t.rooms[roomID]; !exists {
+		return exception.ErrRoomNotFound
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+)
+
+type memoryStudentRepository struct {
+	db memoryDB
+}
+
+func NewMemoryStudentRepository(store *MemoryStore) StudentRepository {
+	return &memoryStudentRepository{db: store}
+}
+
+func (r *memoryStudentRepository) Create(ctx context.Context, student model.Student) (model.Student, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.students[student.ID]; exists {
+			return exception.ErrStudentIDDuplicate
+		}
+		t.students[student.ID] = student
+		return nil
+	})
+	if err != nil {
+		return model.Student{}, err
+	}
+	return student, nil
+}
+
+func (r *memoryStudentRepository) FindByID(ctx context.Context, id int) (model.Student, error) {
+	var student model.Student
+	var exists bool
+	r.db.read(func(t *memoryTables) {
+		student, exists = t.students[id]
+	})
+	if !exists {
+		return model.Student{}, exception.ErrStudentNotFound
+	}
+	return student, nil
+}
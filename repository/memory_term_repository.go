@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"sort"
+)
+
+type memoryTermRepository struct {
+	db memoryDB
+}
+
+func NewMemoryTermRepository(store *MemoryStore) TermRepository {
+	return &memoryTermRepository{db: store}
+}
+
+func (r *memoryTermRepository) Create(ctx context.Context, term model.Term) (model.Term, error) {
+	err := r.db.write(func(t *memoryTables) error {
+		if _, exists := t.terms[term.ID]; exists {
+			return exception.ErrTermDuplicate
+		}
+		t.terms[term.ID] = term
+		return nil
+	})
+	if err != nil {
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *memoryTermRepository) FindByID(ctx context.Context, id string) (model.Term, error) {
+	var term model.Term
+	var exists bool
+	r.db.read(func(t *memoryTables) {
+		term, exists = t.terms[id]
+	})
+	if !exists {
+		return model.Term{}, exception.ErrTermNotFound
+	}
+	return term, nil
+}
+
+func (r *memoryTermRepository) FindAll(ctx context.Context) ([]model.Term, error) {
+	var terms []model.Term
+	r.db.read(func(t *memoryTables) {
+		terms = make([]model.Term, 0, len(t.terms))
+		for _, term := range t.terms {
+			terms = append(terms, term)
+		}
+	})
+	sort.Slice(terms, func(i, j int) bool {
+		return terms[i].StartDate.Before(terms[j].StartDate)
+	})
+	return terms, nil
+}
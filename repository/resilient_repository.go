@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/infrastructure/resilience"
+	"golang-course-registration/model"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// postgrestErrorPattern postgrest-go가 오류 응답을 "(코드) 메시지" 형식으로 반환
+var postgrestErrorPattern = regexp.MustCompile(`^\(([0-9A-Z]+)\) `)
+
+// transientErrorCodes 연결 실패(PGRST0xx, 08), 직렬화 실패/교착(40), 자원 부족(53), 서버 중단(57, 58)
+var transientErrorCodes = []string{"PGRST0", "08", "40", "53", "57", "58"}
+
+// isTransient 저장소 통신 장애 여부
+// 조회 결과 없음, 버전 충돌, 요청 취소, 제약 조건 위반 같은 요청 오류는 다시 보내도 같으므로 장애로 보지 않음
+func isTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// 조회 결과 없음, 버전 충돌, 회로 열림 등 저장소가 이미 분류한 에러
+	var domainErr *exception.Error
+	if errors.As(err, &domainErr) {
+		return false
+	}
+
+	if match := postgrestErrorPattern.FindStringSubmatch(err.Error()); match != nil {
+		for _, prefix := range transientErrorCodes {
+			if strings.HasPrefix(match[1], prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 네트워크 오류, JSON이 아닌 게이트웨이 오류 응답 등
+	return true
+}
+
+// NewStoreBreaker 저장소 통신 장애만 실패로 세는 회로 차단기
+func NewStoreBreaker(threshold int, cooldown time.Duration) *resilience.Breaker {
+	return resilience.NewBreaker(threshold, cooldown, isTransient)
+}
+
+// storeGuard 회로 차단기와 재시도 정책 묶음
+type storeGuard struct {
+	breaker *resilience.Breaker
+	retry   resilience.RetryPolicy
+}
+
+// guardRead 멱등 조회는 장애 시 재시도하고, 끝내 실패하면 resilience.ErrUnavailable로 감쌈
+func guardRead[T any](ctx context.Context, g storeGuard, fn func() (T, error)) (T, error) {
+	var result T
+	err := g.retry.Do(ctx, isTransient, func() error {
+		return g.breaker.Call(ctx, func() error {
+			var err error
+			result, err = fn()
+			return err
+		})
+	})
+	if isTransient(err) {
+		return result, resilience.Unavailable(err)
+	}
+	return result, err
+}
+
+// guardWrite 변경은 반영 여부를 알 수 없으므로 재시도하지 않고 회로 차단기만 적용
+func guardWrite(ctx context.Context, g storeGuard, fn func() error) error {
+	err := g.breaker.Call(ctx, fn)
+	if isTransient(err) {
+		return resilience.Unavailable(err)
+	}
+	return err
+}
+
+type resilientLectureRepository struct {
+	inner LectureRepository
+	guard storeGuard
+}
+
+// NewResilientLectureRepository 조회는 retry 정책으로 재시도하고, 모든 호출에 breaker를 적용
+func NewResilientLectureRepository(inner LectureRepository, breaker *resilience.Breaker, retry resilience.RetryPolicy) LectureRepository {
+	return &resilientLectureRepository{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (r *resilientLectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Lecture, error) {
+		return r.inner.FindAll(ctx)
+	})
+}
+
+func (r *resilientLectureRepository) FindPage(ctx context.Context, query LectureQuery) (LecturePage, error) {
+	return guardRead(ctx, r.guard, func() (LecturePage, error) {
+		return r.inner.FindPage(ctx, query)
+	})
+}
+
+func (r *resilientLectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
+	return guardRead(ctx, r.guard, func() (model.Lecture, error) {
+		return r.inner.FindByID(ctx, id)
+	})
+}
+
+func (r *resilientLectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
+	return guardRead(ctx, r.guard, func() (model.Lecture, error) {
+		return r.inner.FindByName(ctx, termID, name)
+	})
+}
+
+func (r *resilientLectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
+	var created model.Lecture
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		created, err = r.inner.Create(ctx, lecture)
+		return err
+	})
+	return created, err
+}
+
+func (r *resilientLectureRepository) Delete(ctx context.Context, id int) error {
+	return guardWrite(ctx, r.guard, func() error {
+		return r.inner.Delete(ctx, id)
+	})
+}
+
+func (r *resilientLectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
+	return guardWrite(ctx, r.guard, func() error {
+		return r.inner.UpdateCurrentEnrollment(ctx, lectureID, currentEnrollment, expectedVersion)
+	})
+}
+
+func (r *resilientLectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	var updated model.Lecture
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		updated, err = r.inner.Update(ctx, lecture, expectedVersion)
+		return err
+	})
+	return updated, err
+}
+
+type resilientEnrollmentRepository struct {
+	inner EnrollmentRepository
+	guard storeGuard
+}
+
+// NewResilientEnrollmentRepository 조회는 retry 정책으로 재시도하고, 모든 호출에 breaker를 적용
+func NewResilientEnrollmentRepository(inner EnrollmentRepository, breaker *resilience.Breaker, retry resilience.RetryPolicy) EnrollmentRepository {
+	return &resilientEnrollmentRepository{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (r *resilientEnrollmentRepository) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
+	var created model.Enrollment
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		created, err = r.inner.Create(ctx, enrollment)
+		return err
+	})
+	return created, err
+}
+
+func (r *resilientEnrollmentRepository) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Enrollment, error) {
+		return r.inner.FindByStudent(ctx, studentID, statuses...)
+	})
+}
+
+func (r *resilientEnrollmentRepository) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Enrollment, error) {
+		return r.inner.FindByLecture(ctx, lectureID, statuses...)
+	})
+}
+
+func (r *resilientEnrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Lecture, error) {
+		return r.inner.FindLecturesByStudent(ctx, studentID, termID)
+	})
+}
+
+func (r *resilientEnrollmentRepository) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
+	return guardRead(ctx, r.guard, func() (int, error) {
+		return r.inner.CountByLectureID(ctx, lectureID)
+	})
+}
+
+func (r *resilientEnrollmentRepository) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	return guardRead(ctx, r.guard, func() ([]int, error) {
+		return r.inner.FindStudentIDsByLecture(ctx, lectureID)
+	})
+}
+
+func (r *resilientEnrollmentRepository) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	return guardWrite(ctx, r.guard, func() error {
+		return r.inner.UpdateStatus(ctx, enrollment, previous)
+	})
+}
+
+type resilientStudentRepository struct {
+	inner StudentRepository
+	guard storeGuard
+}
+
+// NewResilientStudentRepository 조회는 retry 정책으로 재시도하고, 모든 호출에 breaker를 적용
+func NewResilientStudentRepository(inner StudentRepository, breaker *resilience.Breaker, retry resilience.RetryPolicy) StudentRepository {
+	return &resilientStudentRepository{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (r *resilientStudentRepository) Create(ctx context.Context, student model.Student) (model.Student, error) {
+	var created model.Student
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		created, err = r.inner.Create(ctx, student)
+		return err
+	})
+	return created, err
+}
+
+func (r *resilientStudentRepository) FindByID(ctx context.Context, id int) (model.Student, error) {
+	return guardRead(ctx, r.guard, func() (model.Student, error) {
+		return r.inner.FindByID(ctx, id)
+	})
+}
+
+type resilientTermRepository struct {
+	inner TermRepository
+	guard storeGuard
+}
+
+// NewResilientTermRepository 조회는 retry 정책으로 재시도하고, 모든 호출에 breaker를 적용
+func NewResilientTermRepository(inner TermRepository, breaker *resilience.Breaker, retry resilience.RetryPolicy) TermRepository {
+	return &resilientTermRepository{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (r *resilientTermRepository) Create(ctx context.Context, term model.Term) (model.Term, error) {
+	var created model.Term
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		created, err = r.inner.Create(ctx, term)
+		return err
+	})
+	return created, err
+}
+
+func (r *resilientTermRepository) FindByID(ctx context.Context, id string) (model.Term, error) {
+	return guardRead(ctx, r.guard, func() (model.Term, error) {
+		return r.inner.FindByID(ctx, id)
+	})
+}
+
+func (r *resilientTermRepository) FindAll(ctx context.Context) ([]model.Term, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Term, error) {
+		return r.inner.FindAll(ctx)
+	})
+}
+
+type resilientRoomRepository struct {
+	inner RoomRepository
+	guard storeGuard
+}
+
+// NewResilientRoomRepository 조회는 retry 정책으로 재시도하고, 모든 호출에 breaker를 적용
+func NewResilientRoomRepository(inner RoomRepository, breaker *resilience.Breaker, retry resilience.RetryPolicy) RoomRepository {
+	return &resilientRoomRepository{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (r *resilientRoomRepository) Create(ctx context.Context, room model.Room) (model.Room, error) {
+	var created model.Room
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		created, err = r.inner.Create(ctx, room)
+		return err
+	})
+	return created, err
+}
+
+func (r *resilientRoomRepository) FindByID(ctx context.Context, id string) (model.Room, error) {
+	return guardRead(ctx, r.guard, func() (model.Room, error) {
+		return r.inner.FindByID(ctx, id)
+	})
+}
+
+func (r *resilientRoomRepository) FindAll(ctx context.Context) ([]model.Room, error) {
+	return guardRead(ctx, r.guard, func() ([]model.Room, error) {
+		return r.inner.FindAll(ctx)
+	})
+}
+
+func (r *resilientRoomRepository) Update(ctx context.Context, room model.Room) (model.Room, error) {
+	var updated model.Room
+	err := guardWrite(ctx, r.guard, func() error {
+		var err error
+		updated, err = r.inner.Update(ctx, room)
+		return err
+	})
+	return updated, err
+}
+
+func (r *resilientRoomRepository) Delete(ctx context.Context, id string) error {
+	return guardWrite(ctx, r.guard, func() error {
+		return r.inner.Delete(ctx, id)
+	})
+}
+
+type resilientUnitOfWork struct {
+	inner UnitOfWork
+	guard storeGuard
+}
+
+// NewResilientUnitOfWork 작업 단위 안의 저장소 호출에도 같은 breaker와 retry 정책을 적용
+func NewResilientUnitOfWork(inner UnitOfWork, breaker *resilience.Breaker, retry resilience.RetryPolicy) UnitOfWork {
+	return &resilientUnitOfWork{inner: inner, guard: storeGuard{breaker: breaker, retry: retry}}
+}
+
+func (u *resilientUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	return u.inner.Do(ctx, func(repos Repositories) error {
+		repos.Lectures = &resilientLectureRepository{inner: repos.Lectures, guard: u.guard}
+		repos.Enrollments = &resilientEnrollmentRepository{inner: repos.Enrollments, guard: u.guard}
+		repos.Students = &resilientStudentRepository{inner: repos.Students, guard: u.guard}
+		return fn(repos)
+	})
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/infrastructure/resilience"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/supabase-community/supabase-go"
+)
+
+// fakePostgREST 처음 failures번은 status로 실패하고 이후에는 body로 응답하는 PostgREST 대역
+type fakePostgREST struct {
+	failures int32
+	status   int
+	body     string
+	requests atomic.Int32
+}
+
+func (f *fakePostgREST) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if f.requests.Add(1) <= f.failures {
+		w.WriteHeader(f.status)
+		_, _ = w.Write([]byte(`{"code":"PGRST001","message":"Could not connect to the database"}`))
+		return
+	}
+	_, _ = w.Write([]byte(f.body))
+}
+
+func newResilientLectureRepositoryForTest(t *testing.T, fake *fakePostgREST, breaker *resilience.Breaker) LectureRepository {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := supabase.NewClient(server.URL, "test-key", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retry := resilience.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Millisecond}
+	return NewResilientLectureRepository(NewLectureRepository(client), breaker, retry)
+}
+
+func TestResilientLectureRepository(t *testing.T) {
+	lectureJSON := `[{"id":1001,"name":"데이터베이스","capacity":30,"current_enrollment":0,"credit":3,"slots":[{"day":"MON","start_time":"09:00","end_time":"10:30"}],"version":0}]`
+
+	t.Run("일시적 장애는 재시도하여 성공", func(t *testing.T) {
+		// given
+		fake := &fakePostgREST{failures: 2, status: http.StatusServiceUnavailable, body: lectureJSON}
+		repo := newResilientLectureRepositoryForTest(t, fake, NewStoreBreaker(5, time.Minute))
+
+		// when
+		lecture, err := repo.FindByID(t.Context(), 1001)
+
+		// then
+		if err != nil || lecture.ID != 1001 || fake.requests.Load() != 3 {
+			t.Errorf("기대 : 1001 (요청 3회), 결과 : %d (요청 %d회) %v", lecture.ID, fake.requests.Load(), err)
+		}
+	})
+
+	t.Run("재시도 후에도 실패하면 서비스 사용 불가", func(t *testing.T) {
+		// given
+		fake := &fakePostgREST{failures: 10, status: http.StatusServiceUnavailable, body: lectureJSON}
+		repo := newResilientLectureRepositoryForTest(t, fake, NewStoreBreaker(5, time.Minute))
+
+		// when
+		_, err := repo.FindAll(t.Context())
+
+		// then
+		if !errors.Is(err, resilience.ErrUnavailable) || fake.requests.Load() != 3 {
+			t.Errorf("기대 : %s (요청 3회), 결과 : %v (요청 %d회)", exception.ErrServiceUnavailable, err, fake.requests.Load())
+		}
+	})
+
+	t.Run("연속 실패로 회로가 열리면 요청을 보내지 않음", func(t *testing.T) {
+		// given
+		fake := &fakePostgREST{failures: 10, status: http.StatusServiceUnavailable, body: lectureJSON}
+		breaker := NewStoreBreaker(3, time.Minute)
+		repo := newResilientLectureRepositoryForTest(t, fake, breaker)
+		_, _ = repo.FindAll(t.Context())
+		sent := fake.requests.Load()
+
+		// when
+		_, err := repo.FindByID(t.Context(), 1001)
+
+		// then
+		if !errors.Is(err, resilience.ErrUnavailable) || fake.requests.Load() != sent || breaker.Stats().State != resilience.StateOpen {
+			t.Errorf("기대 : 회로 열림 (추가 요청 없음), 결과 : %s (추가 요청 %d회) %v", breaker.Stats().State, fake.requests.Load()-sent, err)
+		}
+	})
+
+	t.Run("조회 결과 없음은 재시도하지 않고 실패로 세지 않음", func(t *testing.T) {
+		// given
+		fake := &fakePostgREST{body: `[]`}
+		breaker := NewStoreBreaker(1, time.Minute)
+		repo := newResilientLectureRepositoryForTest(t, fake, breaker)
+
+		// when
+		_, err := repo.FindByID(t.Context(), 9999)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureNotFound) || fake.requests.Load() != 1 || breaker.Stats().State != resilience.StateClosed {
+			t.Errorf("기대 : %s (요청 1회), 결과 : %v (요청 %d회, %s)", exception.ErrLectureNotFound, err, fake.requests.Load(), breaker.Stats().State)
+		}
+	})
+
+	t.Run("변경은 재시도하지 않음", func(t *testing.T) {
+		// given
+		fake := &fakePostgREST{failures: 1, status: http.StatusServiceUnavailable, body: `[]`}
+		repo := newResilientLectureRepositoryForTest(t, fake, NewStoreBreaker(5, time.Minute))
+
+		// when
+		err := repo.Delete(t.Context(), 1001)
+
+		// then
+		if !errors.Is(err, resilience.ErrUnavailable) || fake.requests.Load() != 1 {
+			t.Errorf("기대 : %s (요청 1회), 결과 : %v (요청 %d회)", exception.ErrServiceUnavailable, err, fake.requests.Load())
+		}
+	})
+}
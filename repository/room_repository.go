@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+
+	"github.com/supabase-community/postgrest-go"
+	"github.com/supabase-community/supabase-go"
+)
+
+type RoomRepository interface {
+	// Create 같은 강의실 번호가 이미 있으면 ErrRoomDuplicate
+	Create(ctx context.Context, room model.Room) (model.Room, error)
+	FindByID(ctx context.Context, id string) (model.Room, error)
+	// FindAll 강의실 번호 순
+	FindAll(ctx context.Context) ([]model.Room, error)
+	// Update 강의실 이름과 좌석 수 변경
+	Update(ctx context.Context, room model.Room) (model.Room, error)
+	// Delete 강좌가 배정된 강의실이면 ErrRoomInUse
+	Delete(ctx context.Context, id string) error
+}
+
+type roomRepository struct {
+	client *supabase.Client
+}
+
+func NewRoomRepository(client *supabase.Client) RoomRepository {
+	return &roomRepository{client: client}
+}
+
+func (r *roomRepository) Create(ctx context.Context, room model.Room) (model.Room, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Room{}, err
+	}
+
+	_, _, err := r.client.From("rooms").
+		Insert(room, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		if isUniqueViolation(err) {
+			return model.Room{}, exception.ErrRoomDuplicate
+		}
+		return model.Room{}, err
+	}
+	return room, nil
+}
+
+func (r *roomRepository) FindByID(ctx context.Context, id string) (model.Room, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Room{}, err
+	}
+
+	var result []model.Room
+	_, err := r.client.From("rooms").
+		Select("*", "", false).
+		Eq("id", id).
+		Limit(1, "").
+		ExecuteTo(&result)
+	if err != nil {
+		return model.Room{}, err
+	}
+	if len(result) == 0 {
+		return model.Room{}, exception.ErrRoomNotFound
+	}
+	return result[0], nil
+}
+
+func (r *roomRepository) FindAll(ctx context.Context) ([]model.Room, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var result []model.Room
+	_, err := r.client.From("rooms").
+		Select("*", "", false).
+		Order("id", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&result)
+	return result, err
+}
+
+func (r *roomRepository) Update(ctx context.Context, room model.Room) (model.Room, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Room{}, err
+	}
+
+	updateData := map[string]interface{}{
+		"name":  room.Name,
+		"seats": room.Seats,
+	}
+
+	var updated []model.Room
+	_, err := r.client.From("rooms").
+		Update(updateData, "representation", "").
+		Eq("id", room.ID).
+		ExecuteTo(&updated)
+	if err != nil {
+		return model.Room{}, err
+	}
+	if len(updated) == 0 {
+		return model.Room{}, exception.ErrRoomNotFound
+	}
+	return updated[0], nil
+}
+
+func (r *roomRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	_, _, err := r.client.From("rooms").
+		Delete("", "").
+		Eq("id", id).
+		Execute()
+	if err != nil && isForeignKeyViolation(err) {
+		return exception.ErrRoomInUse
+	}
+	return err
+}
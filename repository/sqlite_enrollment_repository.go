@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"strings"
+	"time"
+)
+
+const enrollmentColumns = "id, student_id, lecture_id, term_id, status, waitlisted_at, enrolled_at, dropped_at, withdrawn_at"
+
+type sqliteEnrollmentRepository struct {
+	db sqlExecutor
+}
+
+func NewSQLiteEnrollmentRepository(db *sql.DB) EnrollmentRepository {
+	return &sqliteEnrollmentRepository{db: db}
+}
+
+// nullTime 거치지 않은 상태의 시각(zero value)은 NULL로 저장
+func nullTime(at time.Time) sql.NullTime {
+	return sql.NullTime{Time: at, Valid: !at.IsZero()}
+}
+
+func scanEnrollments(rows *sql.Rows) ([]model.Enrollment, error) {
+	defer rows.Close()
+
+	list := make([]model.Enrollment, 0)
+	for rows.Next() {
+		var enrollment model.Enrollment
+		var waitlistedAt, enrolledAt, droppedAt, withdrawnAt sql.NullTime
+		err := rows.Scan(
+			&enrollment.ID,
+			&enrollment.StudentID,
+			&enrollment.LectureID,
+			&enrollment.TermID,
+			&enrollment.Status,
+			&waitlistedAt,
+			&enrolledAt,
+			&droppedAt,
+			&withdrawnAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		enrollment.WaitlistedAt = waitlistedAt.Time
+		enrollment.EnrolledAt = enrolledAt.Time
+		enrollment.DroppedAt = droppedAt.Time
+		enrollment.WithdrawnAt = withdrawnAt.Time
+		list = append(list, enrollment)
+	}
+	return list, rows.Err()
+}
+
+// statusCondition statuses가 있으면 "AND status IN (?, ...)" 조건과 인자
+func statusCondition(statuses []model.EnrollmentStatus) (string, []interface{}) {
+	if len(statuses) == 0 {
+		return "", nil
+	}
+
+	args := make([]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		args = append(args, string(status))
+	}
+	return " AND status IN (?" + strings.Repeat(", ?", len(statuses)-1) + ")", args
+}
+
+func (r *sqliteEnrollmentRepository) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
+	result, err := r.db.ExecContext(ctx,
+		`INSERT INTO enrollments (student_id, lecture_id, term_id, status, waitlisted_at, enrolled_at, dropped_at, withdrawn_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		enrollment.StudentID,
+		enrollment.LectureID,
+		enrollment.TermID,
+		string(enrollment.Status),
+		nullTime(enrollment.WaitlistedAt),
+		nullTime(enrollment.EnrolledAt),
+		nullTime(enrollment.DroppedAt),
+		nullTime(enrollment.WithdrawnAt),
+	)
+	if err != nil {
+		return model.Enrollment{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return model.Enrollment{}, err
+	}
+
+	enrollment.ID = int(id)
+	return enrollment, nil
+}
+
+func (r *sqliteEnrollmentRepository) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	condition, args := statusCondition(statuses)
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+enrollmentColumns+" FROM enrollments WHERE student_id = ?"+condition+" ORDER BY id DESC",
+		append([]interface{}{studentID}, args...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanEnrollments(rows)
+}
+
+func (r *sqliteEnrollmentRepository) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	condition, args := statusCondition(statuses)
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+enrollmentColumns+" FROM enrollments WHERE lecture_id = ?"+condition+" ORDER BY id ASC",
+		append([]interface{}{lectureID}, args...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanEnrollments(rows)
+}
+
+// FindLecturesByStudent 수강 중인 수강신청과 강좌를 내부 조인하여 학생의 수강 강좌 조회
+func (r *sqliteEnrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.term_id, l.name, l.capacity, l.current_enrollment, l.credit, l.slots, l.room_id, l.discontinued, l.version
+		FROM lectures l
+		INNER JOIN enrollments e ON e.lecture_id = l.id
+		WHERE e.student_id = ? AND e.status = ? AND (? = '' OR l.term_id = ?)
+		ORDER BY l.id ASC`,
+		studentID,
+		string(model.EnrollmentStatusEnrolled),
+		termID,
+		termID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanLectures(rows)
+}
+
+func (r *sqliteEnrollmentRepository) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM enrollments WHERE lecture_id = ? AND status = ?",
+		lectureID,
+		string(model.EnrollmentStatusEnrolled),
+	).Scan(&count)
+	return count, err
+}
+
+func (r *sqliteEnrollmentRepository) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT student_id FROM enrollments WHERE lecture_id = ? AND status = ? ORDER BY student_id ASC",
+		lectureID,
+		string(model.EnrollmentStatusEnrolled),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	studentIDs := make([]int, 0)
+	for rows.Next() {
+		var studentID int
+		if err := rows.Scan(&studentID); err != nil {
+			return nil, err
+		}
+		studentIDs = append(studentIDs, studentID)
+	}
+	return studentIDs, rows.Err()
+}
+
+func (r *sqliteEnrollmentRepository) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE enrollments
+		SET status = ?, waitlisted_at = ?, enrolled_at = ?, dropped_at = ?, withdrawn_at = ?
+		WHERE id = ? AND status = ?`,
+		string(enrollment.Status),
+		nullTime(enrollment.WaitlistedAt),
+		nullTime(enrollment.EnrolledAt),
+		nullTime(enrollment.DroppedAt),
+		nullTime(enrollment.WithdrawnAt),
+		enrollment.ID,
+		string(previous),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return exception.ErrEnrollmentNotFound
+	}
+	return nil
+}
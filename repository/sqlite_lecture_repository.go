@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const lectureColumns = "id, term_id, name, capacity, current_enrollment, credit, slots, room_id, discontinued, version"
+
+// sqliteSlotTimes 강좌의 수업 시간 목록(JSON 배열)을 원소(value)별 행으로 펼치는 FROM 절
+const sqliteSlotTimes = " FROM json_each(lectures.slots)"
+
+type sqliteLectureRepository struct {
+	db sqlExecutor
+}
+
+func NewSQLiteLectureRepository(db *sql.DB) LectureRepository {
+	return &sqliteLectureRepository{db: db}
+}
+
+// rowScanner sql.Row, sql.Rows 공통 인터페이스
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanLecture(row rowScanner) (model.Lecture, error) {
+	var lecture model.Lecture
+	var slots string
+	var roomID sql.NullString
+	err := row.Scan(
+		&lecture.ID,
+		&lecture.TermID,
+		&lecture.Name,
+		&lecture.Capacity,
+		&lecture.CurrentEnrollment,
+		&lecture.Credit,
+		&slots,
+		&roomID,
+		&lecture.Discontinued,
+		&lecture.Version,
+	)
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	if err := json.Unmarshal([]byte(slots), &lecture.Slots); err != nil {
+		return model.Lecture{}, err
+	}
+	lecture.RoomID = roomID.String
+	return lecture, nil
+}
+
+// marshalSlots 수업 시간 목록을 slots 컬럼에 저장할 JSON 배열로 변환
+func marshalSlots(slots []model.MeetingSlot) (string, error) {
+	if slots == nil {
+		slots = []model.MeetingSlot{}
+	}
+	encoded, err := json.Marshal(slots)
+	return string(encoded), err
+}
+
+func scanLectures(rows *sql.Rows) ([]model.Lecture, error) {
+	defer rows.Close()
+
+	lectures := make([]model.Lecture, 0)
+	for rows.Next() {
+		lecture, err := scanLecture(rows)
+		if err != nil {
+			return nil, err
+		}
+		lectures = append(lectures, lecture)
+	}
+	return lectures, rows.Err()
+}
+
+func (r *sqliteLectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+lectureColumns+" FROM lectures ORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	return scanLectures(rows)
+}
+
+func (r *sqliteLectureRepository) FindPage(ctx context.Context, query LectureQuery) (LecturePage, error) {
+	where, args := sqliteLectureConditions(query)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM lectures"+where, args...).Scan(&total); err != nil {
+		return LecturePage{}, err
+	}
+
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+	limit := -1
+	if query.Limit > 0 {
+		limit = query.Limit
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+lectureColumns+" FROM lectures"+where+
+			" ORDER BY "+sqliteLectureSortColumn(query.sortKey())+" "+direction+", id ASC LIMIT ? OFFSET ?",
+		append(args, limit, query.Offset)...,
+	)
+	if err != nil {
+		return LecturePage{}, err
+	}
+
+	lectures, err := scanLectures(rows)
+	if err != nil {
+		return LecturePage{}, err
+	}
+	return LecturePage{Lectures: lectures, Total: total}, nil
+}
+
+// sqliteLectureConditions 조회 조건을 WHERE 절과 인자로 변환
+func sqliteLectureConditions(query LectureQuery) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	if query.TermID != "" {
+		conditions = append(conditions, "term_id = ?")
+		args = append(args, query.TermID)
+	}
+	if query.RoomID != "" {
+		conditions = append(conditions, "room_id = ?")
+		args = append(args, query.RoomID)
+	}
+	if query.Day != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1"+sqliteSlotTimes+" WHERE json_extract(value, '$.day') = ?)")
+		args = append(args, query.Day)
+	}
+	if query.Credit != 0 {
+		conditions = append(conditions, "credit = ?")
+		args = append(args, query.Credit)
+	}
+	if query.OpenOnly {
+		conditions = append(conditions, "current_enrollment < capacity")
+	}
+	if query.StartFrom != 0 {
+		conditions = append(conditions, "NOT EXISTS (SELECT 1"+sqliteSlotTimes+" WHERE json_extract(value, '$.start_time') < ?)")
+		args = append(args, query.StartFrom)
+	}
+	if query.EndUntil != 0 {
+		conditions = append(conditions, "NOT EXISTS (SELECT 1"+sqliteSlotTimes+" WHERE json_extract(value, '$.end_time') > ?)")
+		args = append(args, query.EndUntil)
+	}
+	if query.Name != "" {
+		conditions = append(conditions, `name LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+likeEscaper.Replace(query.Name)+"%")
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// sqliteLectureSortColumn 정렬 기준의 ORDER BY 식, 시작 시간은 수업 시간 중 가장 이른 시작 시간
+func sqliteLectureSortColumn(key string) string {
+	if key == LectureSortStartTime {
+		return "(SELECT MIN(json_extract(value, '$.start_time'))" + sqliteSlotTimes + ")"
+	}
+	return key
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *sqliteLectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+lectureColumns+" FROM lectures WHERE id = ?", id)
+	return r.scanOne(row)
+}
+
+func (r *sqliteLectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+lectureColumns+" FROM lectures WHERE term_id = ? AND name = ? LIMIT 1", termID, name)
+	return r.scanOne(row)
+}
+
+func (r *sqliteLectureRepository) scanOne(row *sql.Row) (model.Lecture, error) {
+	lecture, err := scanLecture(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Lecture{}, exception.ErrLectureNotFound
+	}
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	return lecture, nil
+}
+
+func (r *sqliteLectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
+	slots, err := marshalSlots(lecture.Slots)
+	if err != nil {
+		return model.Lecture{}, err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO lectures ("+lectureColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		lecture.ID,
+		lecture.TermID,
+		lecture.Name,
+		lecture.Capacity,
+		lecture.CurrentEnrollment,
+		lecture.Credit,
+		slots,
+		nullableString(lecture.RoomID),
+		lecture.Discontinued,
+		lecture.Version,
+	)
+	if err != nil {
+		return model.Lecture{}, r.constraintError(ctx, err, lecture)
+	}
+	return r.FindByID(ctx, lecture.ID)
+}
+
+// constraintError 서비스의 사전 검사를 동시 요청이 통과해 제약 조건에 걸린 경우 메모리 저장소와 같은 도메인 에러로 변환
+// 외래키 위반은 어느 제약인지 알려주지 않으므로 배정할 강의실이 없으면 ErrRoomNotFound, 아니면 학기가 없는 것으로 봄
+func (r *sqliteLectureRepository) constraintError(ctx context.Context, err error, lecture model.Lecture) error {
+	switch sqliteConstraintCode(err) {
+	case sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return exception.ErrLectureIDDuplicate
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+		return exception.ErrLectureNameDuplicate
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		if lecture.RoomID != "" {
+			rooms := &sqliteRoomRepository{db: r.db}
+			if _, roomErr := rooms.FindByID(ctx, lecture.RoomID); errors.Is(roomErr, exception.ErrRoomNotFound) {
+				return exception.ErrRoomNotFound
+			}
+		}
+		return exception.ErrTermNotFound
+	}
+	return err
+}
+
+// sqliteConstraintCode SQLite 제약 조건 위반의 확장 오류 코드 (예: SQLITE_CONSTRAINT_UNIQUE), 다른 오류면 0
+func sqliteConstraintCode(err error) int {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code()&0xff == sqlite3.SQLITE_CONSTRAINT {
+		return sqliteErr.Code()
+	}
+	return 0
+}
+
+func (r *sqliteLectureRepository) Delete(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM lectures WHERE id = ?", id)
+	return err
+}
+
+func (r *sqliteLectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE lectures SET current_enrollment = ?, version = version + 1 WHERE id = ? AND version = ?",
+		currentEnrollment,
+		lectureID,
+		expectedVersion,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		if _, err := r.FindByID(ctx, lectureID); err != nil {
+			return err
+		}
+		return &ConflictError{LectureID: lectureID, ExpectedVersion: expectedVersion}
+	}
+	return nil
+}
+
+func (r *sqliteLectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	slots, err := marshalSlots(lecture.Slots)
+	if err != nil {
+		return model.Lecture{}, err
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE lectures SET name = ?, capacity = ?, credit = ?, slots = ?, room_id = ?, discontinued = ?, version = version + 1 WHERE id = ? AND version = ?",
+		lecture.Name,
+		lecture.Capacity,
+		lecture.Credit,
+		slots,
+		nullableString(lecture.RoomID),
+		lecture.Discontinued,
+		lecture.ID,
+		expectedVersion,
+	)
+	if err != nil {
+		return model.Lecture{}, r.constraintError(ctx, err, lecture)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return model.Lecture{}, err
+	}
+	if affected == 0 {
+		if _, err := r.FindByID(ctx, lecture.ID); err != nil {
+			return model.Lecture{}, err
+		}
+		return model.Lecture{}, &ConflictError{LectureID: lecture.ID, ExpectedVersion: expectedVersion}
+	}
+	return r.FindByID(ctx, lecture.ID)
+}
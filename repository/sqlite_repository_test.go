@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/infrastructure/database"
+	"golang-course-registration/model"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestSQLiteDB(t *testing.T) *sql.DB {
+	t.Helper()
+	store, err := database.NewSQLite(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("SQLite 저장소 생성 실패 : %v", err)
+	}
+	t.Cleanup(func() { store.DB.Close() })
+	return store.DB
+}
+
+// inDefaultTerm 마이그레이션이 등록한 기본 학기의 강좌 (학기는 외래키)
+func inDefaultTerm(lecture *model.Lecture) model.Lecture {
+	lecture.TermID = constants.DefaultTermID
+	return *lecture
+}
+
+func TestSQLiteLectureRepository(t *testing.T) {
+	t.Run("강좌 생성 및 조회", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+
+		// when
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+		found, err := repo.FindByName(t.Context(), constants.DefaultTermID, "데이터베이스")
+
+		// then
+		if err != nil || found.ID != 1001 || !found.HasSameSchedule(lecture) {
+			t.Errorf("기대 : 1001 월요일 09:00, 결과 : %+v (%v)", found, err)
+		}
+	})
+
+	t.Run("강좌명은 학기 안에서만 고유", func(t *testing.T) {
+		// given
+		db := newTestSQLiteDB(t)
+		repo := NewSQLiteLectureRepository(db)
+		nextTerm, _ := model.NewTerm("2027-1", "2027-03-02", "2027-06-20")
+		_, _ = NewSQLiteTermRepository(db).Create(t.Context(), *nextTerm)
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+		same, _ := model.NewLecture(1002, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		same.TermID = constants.DefaultTermID
+
+		// when
+		_, errSameTerm := repo.Create(t.Context(), *same)
+		same.TermID = nextTerm.ID
+		_, errNextTerm := repo.Create(t.Context(), *same)
+
+		// then
+		found, _ := repo.FindByName(t.Context(), nextTerm.ID, "데이터베이스")
+		if errSameTerm == nil || errNextTerm != nil || found.ID != 1002 {
+			t.Errorf("기대 : 같은 학기만 거부, 결과 : %v, %v (%+v)", errSameTerm, errNextTerm, found)
+		}
+	})
+
+	t.Run("예외 : 존재하지 않는 강좌", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+
+		// when
+		_, err := repo.FindByID(t.Context(), 9999)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
+		}
+	})
+
+	t.Run("강좌 삭제 시 수강신청 연쇄 삭제", func(t *testing.T) {
+		// given
+		db := newTestSQLiteDB(t)
+		lectureRepo := NewSQLiteLectureRepository(db)
+		enrollmentRepo := NewSQLiteEnrollmentRepository(db)
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = lectureRepo.Create(t.Context(), inDefaultTerm(lecture))
+		_, _ = NewSQLiteStudentRepository(db).Create(t.Context(), model.Student{ID: 2001})
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled})
+
+		// when
+		_ = lectureRepo.Delete(t.Context(), 1001)
+
+		// then
+		count, _ := enrollmentRepo.CountByLectureID(t.Context(), 1001)
+		if count != 0 {
+			t.Errorf("기대 : 0, 결과 : %d", count)
+		}
+	})
+
+	t.Run("수강 인원 갱신 시 버전 증가", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+
+		// when
+		err := repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// then
+		updated, _ := repo.FindByID(t.Context(), 1001)
+		if err != nil || updated.CurrentEnrollment != 1 || updated.Version != 1 {
+			t.Errorf("기대 : (1, 버전 1), 결과 : (%d, 버전 %d) %v", updated.CurrentEnrollment, updated.Version, err)
+		}
+	})
+
+	t.Run("예외 : 버전 충돌", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+		_ = repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// when
+		err := repo.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+
+		// then
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureVersionConflict, err)
+		}
+	})
+
+	t.Run("강좌 정보 변경 시 수강 인원 유지, 버전 증가", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+		_ = repo.UpdateCurrentEnrollment(t.Context(), 1001, 5, 0)
+		revised, _ := lecture.Revise("고급 데이터베이스", 30, 3, []model.MeetingSlotInput{
+			{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"},
+			{Day: model.Friday, StartTime: "09:00", EndTime: "10:30"},
+		})
+		revised.CurrentEnrollment = 0
+
+		// when
+		updated, err := repo.Update(t.Context(), revised, 1)
+
+		// then
+		if err != nil || updated.Name != "고급 데이터베이스" || !updated.HasSameSchedule(&revised) ||
+			updated.CurrentEnrollment != 5 || updated.Version != 2 {
+			t.Errorf("기대 : (고급 데이터베이스, 월/금요일, 5, 버전 2), 결과 : %+v (%v)", updated, err)
+		}
+
+		_, err = repo.Update(t.Context(), revised, 1)
+		var conflict *ConflictError
+		if !errors.As(err, &conflict) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureVersionConflict, err)
+		}
+	})
+
+	t.Run("예외 : 제약 조건 위반은 메모리 저장소와 같은 도메인 에러", func(t *testing.T) {
+		// given
+		repo := NewSQLiteLectureRepository(newTestSQLiteDB(t))
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(lecture))
+		other, _ := model.NewLecture(1002, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+		_, _ = repo.Create(t.Context(), inDefaultTerm(other))
+		sameID, _ := model.NewLecture(1001, "컴파일러", 30, 3, model.Friday, "09:00", "10:30")
+		renamed := inDefaultTerm(other)
+		renamed.Name = "데이터베이스"
+		unknownRoom := inDefaultTerm(other)
+		unknownRoom.RoomID = "ENG-999"
+
+		// when
+		_, errID := repo.Create(t.Context(), inDefaultTerm(sameID))
+		_, errName := repo.Update(t.Context(), renamed, 0)
+		_, errRoom := repo.Update(t.Context(), unknownRoom, 0)
+
+		// then
+		if !errors.Is(errID, exception.ErrLectureIDDuplicate) || !errors.Is(errName, exception.ErrLectureNameDuplicate) ||
+			!errors.Is(errRoom, exception.ErrRoomNotFound) {
+			t.Errorf("기대 : %s, %s, %s, 결과 : %v, %v, %v", exception.ErrLectureIDDuplicate, exception.ErrLectureNameDuplicate,
+				exception.ErrRoomNotFound, errID, errName, errRoom)
+		}
+	})
+}
+
+func TestSQLiteEnrollmentRepository(t *testing.T) {
+	t.Run("학생별 수강 강좌 조회", func(t *testing.T) {
+		// given
+		db := newTestSQLiteDB(t)
+		lectureRepo := NewSQLiteLectureRepository(db)
+		enrollmentRepo := NewSQLiteEnrollmentRepository(db)
+		_, _ = NewSQLiteStudentRepository(db).Create(t.Context(), model.Student{ID: 2001})
+		lecture1, _ := model.NewLecture(1002, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		lecture2, _ := model.NewLecture(1001, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+		_, _ = lectureRepo.Create(t.Context(), inDefaultTerm(lecture1))
+		_, _ = lectureRepo.Create(t.Context(), inDefaultTerm(lecture2))
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1002, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled})
+		_, _ = enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled})
+
+		// when
+		lectures, _ := enrollmentRepo.FindLecturesByStudent(t.Context(), 2001, "")
+
+		// then
+		if len(lectures) != 2 || lectures[0].ID != 1001 {
+			t.Errorf("기대 : 2개 (첫 강좌 1001), 결과 : %v", lectures)
+		}
+	})
+
+	t.Run("예외 : 존재하지 않는 강좌 (외래키)", func(t *testing.T) {
+		// given
+		db := newTestSQLiteDB(t)
+		_, _ = NewSQLiteStudentRepository(db).Create(t.Context(), model.Student{ID: 2001})
+		enrollmentRepo := NewSQLiteEnrollmentRepository(db)
+
+		// when
+		_, err := enrollmentRepo.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled})
+
+		// then
+		if err == nil {
+			t.Error("외래키 제약조건 오류가 발생해야 합니다.")
+		}
+	})
+
+	t.Run("예외 : 존재하지 않는 수강신청 상태 변경", func(t *testing.T) {
+		// given
+		enrollmentRepo := NewSQLiteEnrollmentRepository(newTestSQLiteDB(t))
+
+		// when
+		err := enrollmentRepo.UpdateStatus(t.Context(), model.Enrollment{ID: 1, Status: model.EnrollmentStatusDropped}, model.EnrollmentStatusEnrolled)
+
+		// then
+		if !errors.Is(err, exception.ErrEnrollmentNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentNotFound, err)
+		}
+	})
+}
+
+func TestSQLiteStudentRepository(t *testing.T) {
+	t.Run("예외 : 중복된 학번", func(t *testing.T) {
+		// given
+		repo := NewSQLiteStudentRepository(newTestSQLiteDB(t))
+		_, _ = repo.Create(t.Context(), model.Student{ID: 2001})
+
+		// when
+		_, err := repo.Create(t.Context(), model.Student{ID: 2001})
+
+		// then
+		if !errors.Is(err, exception.ErrStudentIDDuplicate) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrStudentIDDuplicate, err)
+		}
+	})
+}
+
+func TestSQLiteEnrollmentStatus(t *testing.T) {
+	at := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+	newFixture := func(t *testing.T) EnrollmentRepository {
+		db := newTestSQLiteDB(t)
+		lecture, _ := model.NewLecture(1001, "데이터베이스", 1, 3, model.Monday, "09:00", "10:30")
+		_, _ = NewSQLiteLectureRepository(db).Create(t.Context(), inDefaultTerm(lecture))
+		for _, id := range []int{2001, 2002} {
+			_, _ = NewSQLiteStudentRepository(db).Create(t.Context(), model.Student{ID: id})
+		}
+		return NewSQLiteEnrollmentRepository(db)
+	}
+
+	t.Run("취소한 수강신청은 이력으로 남고 수강 인원에서 제외", func(t *testing.T) {
+		// given
+		repo := newFixture(t)
+		enrolled, _ := model.NewEnrollment(2001, 1001, constants.DefaultTermID, at)
+		created, _ := repo.Create(t.Context(), *enrolled)
+		dropped, _ := created.Drop(at.Add(time.Hour))
+
+		// when
+		err := repo.UpdateStatus(t.Context(), dropped, model.EnrollmentStatusEnrolled)
+
+		// then
+		count, _ := repo.CountByLectureID(t.Context(), 1001)
+		lectures, _ := repo.FindLecturesByStudent(t.Context(), 2001, "")
+		history, _ := repo.FindByStudent(t.Context(), 2001, model.EnrollmentStatusDropped)
+		if err != nil || count != 0 || len(lectures) != 0 || len(history) != 1 ||
+			!history[0].EnrolledAt.Equal(at) || !history[0].DroppedAt.Equal(at.Add(time.Hour)) || !history[0].WithdrawnAt.IsZero() {
+			t.Errorf("기대 : 수강 0명, DROPPED 이력 1건 (시각 유지), 결과 : %d, %d, %+v (%v)", count, len(lectures), history, err)
+		}
+	})
+
+	t.Run("대기 목록은 등록 순서대로 조회", func(t *testing.T) {
+		// given
+		repo := newFixture(t)
+		for _, studentID := range []int{2002, 2001} {
+			waiting, _ := model.NewWaitlistedEnrollment(studentID, 1001, constants.DefaultTermID, at)
+			_, _ = repo.Create(t.Context(), *waiting)
+		}
+
+		// when
+		waitlist, err := repo.FindByLecture(t.Context(), 1001, model.EnrollmentStatusWaitlisted)
+
+		// then
+		if err != nil || model.WaitlistPosition(waitlist, 2002) != 1 || model.WaitlistPosition(waitlist, 2001) != 2 {
+			t.Errorf("기대 : 2002, 2001 순, 결과 : %+v (%v)", waitlist, err)
+		}
+	})
+
+	t.Run("예외 : 이미 바뀐 상태에서 다시 변경", func(t *testing.T) {
+		// given
+		repo := newFixture(t)
+		enrolled, _ := model.NewEnrollment(2001, 1001, constants.DefaultTermID, at)
+		created, _ := repo.Create(t.Context(), *enrolled)
+		dropped, _ := created.Drop(at)
+		_ = repo.UpdateStatus(t.Context(), dropped, model.EnrollmentStatusEnrolled)
+
+		// when
+		err := repo.UpdateStatus(t.Context(), dropped, model.EnrollmentStatusEnrolled)
+
+		// then
+		if !errors.Is(err, exception.ErrEnrollmentNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrEnrollmentNotFound, err)
+		}
+	})
+}
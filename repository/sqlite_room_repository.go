@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+)
+
+type sqliteRoomRepository struct {
+	db sqlExecutor
+}
+
+func NewSQLiteRoomRepository(db *sql.DB) RoomRepository {
+	return &sqliteRoomRepository{db: db}
+}
+
+func (r *sqliteRoomRepository) Create(ctx context.Context, room model.Room) (model.Room, error) {
+	if _, err := r.FindByID(ctx, room.ID); err == nil {
+		return model.Room{}, exception.ErrRoomDuplicate
+	}
+
+	_, err := r.db.ExecContext(ctx, "INSERT INTO rooms (id, name, seats) VALUES (?, ?, ?)", room.ID, room.Name, room.Seats)
+	if err != nil {
+		return model.Room{}, err
+	}
+	return room, nil
+}
+
+func (r *sqliteRoomRepository) FindByID(ctx context.Context, id string) (model.Room, error) {
+	var room model.Room
+	err := r.db.QueryRowContext(ctx, "SELECT id, name, seats FROM rooms WHERE id = ?", id).
+		Scan(&room.ID, &room.Name, &room.Seats)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Room{}, exception.ErrRoomNotFound
+	}
+	if err != nil {
+		return model.Room{}, err
+	}
+	return room, nil
+}
+
+func (r *sqliteRoomRepository) FindAll(ctx context.Context) ([]model.Room, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, seats FROM rooms ORDER BY id ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rooms := make([]model.Room, 0)
+	for rows.Next() {
+		var room model.Room
+		if err := rows.Scan(&room.ID, &room.Name, &room.Seats); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, rows.Err()
+}
+
+func (r *sqliteRoomRepository) Update(ctx context.Context, room model.Room) (model.Room, error) {
+	result, err := r.db.ExecContext(ctx, "UPDATE rooms SET name = ?, seats = ? WHERE id = ?", room.Name, room.Seats, room.ID)
+	if err != nil {
+		return model.Room{}, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return model.Room{}, err
+	}
+	if affected == 0 {
+		return model.Room{}, exception.ErrRoomNotFound
+	}
+	return room, nil
+}
+
+func (r *sqliteRoomRepository) Delete(ctx context.Context, id string) error {
+	var inUse bool
+	if err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM lectures WHERE room_id = ?)", id).Scan(&inUse); err != nil {
+		return err
+	}
+	if inUse {
+		return exception.ErrRoomInUse
+	}
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM rooms WHERE id = ?", id)
+	return err
+}
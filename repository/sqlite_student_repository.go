@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+)
+
+type sqliteStudentRepository struct {
+	db sqlExecutor
+}
+
+func NewSQLiteStudentRepository(db *sql.DB) StudentRepository {
+	return &sqliteStudentRepository{db: db}
+}
+
+func (r *sqliteStudentRepository) Create(ctx context.Context, student model.Student) (model.Student, error) {
+	if _, err := r.FindByID(ctx, student.ID); err == nil {
+		return model.Student{}, exception.ErrStudentIDDuplicate
+	}
+
+	if _, err := r.db.ExecContext(ctx, "INSERT INTO students (id) VALUES (?)", student.ID); err != nil {
+		return model.Student{}, err
+	}
+	return student, nil
+}
+
+func (r *sqliteStudentRepository) FindByID(ctx context.Context, id int) (model.Student, error) {
+	var student model.Student
+	err := r.db.QueryRowContext(ctx, "SELECT id FROM students WHERE id = ?", id).Scan(&student.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Student{}, exception.ErrStudentNotFound
+	}
+	if err != nil {
+		return model.Student{}, err
+	}
+	return student, nil
+}
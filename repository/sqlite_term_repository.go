@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"time"
+)
+
+type sqliteTermRepository struct {
+	db sqlExecutor
+}
+
+func NewSQLiteTermRepository(db *sql.DB) TermRepository {
+	return &sqliteTermRepository{db: db}
+}
+
+func scanTerm(row rowScanner) (model.Term, error) {
+	var term model.Term
+	var startDate, endDate string
+	if err := row.Scan(&term.ID, &startDate, &endDate); err != nil {
+		return model.Term{}, err
+	}
+
+	var err error
+	if term.StartDate, err = time.Parse(model.TermDateLayout, startDate); err != nil {
+		return model.Term{}, err
+	}
+	if term.EndDate, err = time.Parse(model.TermDateLayout, endDate); err != nil {
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *sqliteTermRepository) Create(ctx context.Context, term model.Term) (model.Term, error) {
+	if _, err := r.FindByID(ctx, term.ID); err == nil {
+		return model.Term{}, exception.ErrTermDuplicate
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO terms (id, start_date, end_date) VALUES (?, ?, ?)",
+		term.ID,
+		term.StartDate.Format(model.TermDateLayout),
+		term.EndDate.Format(model.TermDateLayout),
+	)
+	if err != nil {
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *sqliteTermRepository) FindByID(ctx context.Context, id string) (model.Term, error) {
+	term, err := scanTerm(r.db.QueryRowContext(ctx, "SELECT id, start_date, end_date FROM terms WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Term{}, exception.ErrTermNotFound
+	}
+	if err != nil {
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *sqliteTermRepository) FindAll(ctx context.Context) ([]model.Term, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, start_date, end_date FROM terms ORDER BY start_date ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := make([]model.Term, 0)
+	for rows.Next() {
+		term, err := scanTerm(rows)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, rows.Err()
+}
@@ -1,7 +1,7 @@
 package repository
 
 import (
-	"errors"
+	"context"
 	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
 	"strconv"
@@ -10,29 +10,49 @@ import (
 )
 
 type StudentRepository interface {
-	Create(student model.Student) (model.Student, error)
-	FindByID(id int) (model.Student, error)
+	Create(ctx context.Context, student model.Student) (model.Student, error)
+	FindByID(ctx context.Context, id int) (model.Student, error)
 }
 
 type studentRepository struct {
 	client *supabase.Client
+	undo   *compensationLog
 }
 
 func NewStudentRepository(client *supabase.Client) StudentRepository {
 	return &studentRepository{client: client}
 }
 
-func (r *studentRepository) Create(student model.Student) (model.Student, error) {
+func (r *studentRepository) Create(ctx context.Context, student model.Student) (model.Student, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Student{}, err
+	}
+
 	_, _, err := r.client.From("students").
 		Insert(student, false, "", "minimal", "").
 		Execute()
 	if err != nil {
+		if isUniqueViolation(err) {
+			return model.Student{}, exception.ErrStudentIDDuplicate
+		}
 		return model.Student{}, err
 	}
+
+	r.undo.record(func() error {
+		_, _, err := r.client.From("students").
+			Delete("", "").
+			Eq("id", strconv.Itoa(student.ID)).
+			Execute()
+		return err
+	})
 	return student, nil
 }
 
-func (r *studentRepository) FindByID(id int) (model.Student, error) {
+func (r *studentRepository) FindByID(ctx context.Context, id int) (model.Student, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Student{}, err
+	}
+
 	var list []model.Student
 	_, err := r.client.From("students").
 		Select("*", "", false).
@@ -43,7 +63,7 @@ func (r *studentRepository) FindByID(id int) (model.Student, error) {
 		return model.Student{}, err
 	}
 	if len(list) == 0 {
-		return model.Student{}, errors.New(exception.ErrStudentNotFound)
+		return model.Student{}, exception.ErrStudentNotFound
 	}
 	return list[0], nil
 }
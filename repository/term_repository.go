@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/model"
+	"time"
+
+	"github.com/supabase-community/postgrest-go"
+	"github.com/supabase-community/supabase-go"
+)
+
+type TermRepository interface {
+	// Create 같은 학기가 이미 있으면 ErrTermDuplicate
+	Create(ctx context.Context, term model.Term) (model.Term, error)
+	FindByID(ctx context.Context, id string) (model.Term, error)
+	// FindAll 시작일 순
+	FindAll(ctx context.Context) ([]model.Term, error)
+}
+
+type termRepository struct {
+	client *supabase.Client
+}
+
+// termRecord 시작/종료일은 date 컬럼이므로 "YYYY-MM-DD" 문자열로 주고받음
+type termRecord struct {
+	ID        string `json:"id"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+func (tr termRecord) toModel() (model.Term, error) {
+	start, err := time.Parse(model.TermDateLayout, tr.StartDate)
+	if err != nil {
+		return model.Term{}, err
+	}
+	end, err := time.Parse(model.TermDateLayout, tr.EndDate)
+	if err != nil {
+		return model.Term{}, err
+	}
+	return model.Term{ID: tr.ID, StartDate: start, EndDate: end}, nil
+}
+
+func NewTermRepository(client *supabase.Client) TermRepository {
+	return &termRepository{client: client}
+}
+
+func (r *termRepository) Create(ctx context.Context, term model.Term) (model.Term, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Term{}, err
+	}
+
+	record := termRecord{
+		ID:        term.ID,
+		StartDate: term.StartDate.Format(model.TermDateLayout),
+		EndDate:   term.EndDate.Format(model.TermDateLayout),
+	}
+	_, _, err := r.client.From("terms").
+		Insert(record, false, "", "minimal", "").
+		Execute()
+	if err != nil {
+		if isUniqueViolation(err) {
+			return model.Term{}, exception.ErrTermDuplicate
+		}
+		return model.Term{}, err
+	}
+	return term, nil
+}
+
+func (r *termRepository) FindByID(ctx context.Context, id string) (model.Term, error) {
+	if err := ctx.Err(); err != nil {
+		return model.Term{}, err
+	}
+
+	var records []termRecord
+	_, err := r.client.From("terms").
+		Select("*", "", false).
+		Eq("id", id).
+		Limit(1, "").
+		ExecuteTo(&records)
+	if err != nil {
+		return model.Term{}, err
+	}
+	if len(records) == 0 {
+		return model.Term{}, exception.ErrTermNotFound
+	}
+	return records[0].toModel()
+}
+
+func (r *termRepository) FindAll(ctx context.Context) ([]model.Term, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var records []termRecord
+	_, err := r.client.From("terms").
+		Select("*", "", false).
+		Order("start_date", &postgrest.OrderOpts{Ascending: true}).
+		ExecuteTo(&records)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]model.Term, 0, len(records))
+	for _, record := range records {
+		term, err := record.toModel()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
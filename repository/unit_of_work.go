@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/supabase-community/supabase-go"
+)
+
+// Repositories 하나의 작업 단위에 참여하는 저장소 묶음
+type Repositories struct {
+	Lectures    LectureRepository
+	Enrollments EnrollmentRepository
+	Students    StudentRepository
+}
+
+// UnitOfWork fn 안에서 Repositories로 수행한 변경을 모두 반영하거나 모두 되돌림
+// ctx가 취소되면 fn을 시작하지 않고, 이미 시작한 트랜잭션은 롤백
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(repos Repositories) error) error
+}
+
+// sqlExecutor *sql.DB, *sql.Tx 공통 인터페이스
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type passThroughUnitOfWork struct {
+	repos Repositories
+}
+
+// NewPassThroughUnitOfWork 트랜잭션 없이 주어진 저장소를 그대로 사용
+func NewPassThroughUnitOfWork(repos Repositories) UnitOfWork {
+	return &passThroughUnitOfWork{repos: repos}
+}
+
+func (u *passThroughUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fn(u.repos)
+}
+
+type memoryUnitOfWork struct {
+	store *MemoryStore
+}
+
+// NewMemoryUnitOfWork 저장소 잠금을 쥔 채 복사본에 작업하고, 성공 시에만 교체
+func NewMemoryUnitOfWork(store *MemoryStore) UnitOfWork {
+	return &memoryUnitOfWork{store: store}
+}
+
+func (u *memoryUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	u.store.mu.Lock()
+	defer u.store.mu.Unlock()
+
+	tx := &memoryTx{tables: u.store.tables.clone()}
+	err := fn(Repositories{
+		Lectures:    &memoryLectureRepository{db: tx},
+		Enrollments: &memoryEnrollmentRepository{db: tx},
+		Students:    &memoryStudentRepository{db: tx},
+	})
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	u.store.tables = tx.tables
+	return nil
+}
+
+type sqliteUnitOfWork struct {
+	db *sql.DB
+}
+
+// NewSQLiteUnitOfWork DB 트랜잭션으로 커밋 또는 롤백
+func NewSQLiteUnitOfWork(db *sql.DB) UnitOfWork {
+	return &sqliteUnitOfWork{db: db}
+}
+
+func (u *sqliteUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(Repositories{
+		Lectures:    &sqliteLectureRepository{db: tx},
+		Enrollments: &sqliteEnrollmentRepository{db: tx},
+		Students:    &sqliteStudentRepository{db: tx},
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type supabaseUnitOfWork struct {
+	client *supabase.Client
+}
+
+// NewSupabaseUnitOfWork PostgREST는 여러 요청을 하나의 트랜잭션으로 묶을 수 없으므로,
+// 변경마다 보상 작업을 기록해 두었다가 실패 시 역순으로 실행
+func NewSupabaseUnitOfWork(client *supabase.Client) UnitOfWork {
+	return &supabaseUnitOfWork{client: client}
+}
+
+func (u *supabaseUnitOfWork) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	undo := &compensationLog{}
+	err := fn(Repositories{
+		Lectures:    &lectureRepository{client: u.client, undo: undo},
+		Enrollments: &enrollmentRepository{client: u.client, undo: undo},
+		Students:    &studentRepository{client: u.client, undo: undo},
+	})
+	if err != nil {
+		if rollbackErr := undo.rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// compensationLog 이미 반영된 변경을 되돌리는 보상 작업 목록
+type compensationLog struct {
+	actions []func() error
+}
+
+// record 작업 단위 밖(nil)에서는 아무것도 기록하지 않음
+func (l *compensationLog) record(action func() error) {
+	if l == nil {
+		return
+	}
+	l.actions = append(l.actions, action)
+}
+
+func (l *compensationLog) rollback() error {
+	var errs []error
+	for i := len(l.actions) - 1; i >= 0; i-- {
+		if err := l.actions[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
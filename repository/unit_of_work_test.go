@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"errors"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/model"
+	"testing"
+)
+
+func TestUnitOfWork(t *testing.T) {
+	backends := map[string]func(t *testing.T) (UnitOfWork, Repositories){
+		"memory": func(t *testing.T) (UnitOfWork, Repositories) {
+			store := NewMemoryStore()
+			return NewMemoryUnitOfWork(store), Repositories{
+				Lectures:    NewMemoryLectureRepository(store),
+				Enrollments: NewMemoryEnrollmentRepository(store),
+				Students:    NewMemoryStudentRepository(store),
+			}
+		},
+		"sqlite": func(t *testing.T) (UnitOfWork, Repositories) {
+			db := newTestSQLiteDB(t)
+			return NewSQLiteUnitOfWork(db), Repositories{
+				Lectures:    NewSQLiteLectureRepository(db),
+				Enrollments: NewSQLiteEnrollmentRepository(db),
+				Students:    NewSQLiteStudentRepository(db),
+			}
+		},
+	}
+
+	for name, newBackend := range backends {
+		setup := func(t *testing.T) (UnitOfWork, Repositories) {
+			uow, repos := newBackend(t)
+			lecture, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			_, _ = repos.Lectures.Create(t.Context(), inDefaultTerm(lecture))
+			_, _ = repos.Students.Create(t.Context(), model.Student{ID: 2001})
+			return uow, repos
+		}
+
+		t.Run(name+" : 성공 시 커밋", func(t *testing.T) {
+			// given
+			uow, repos := setup(t)
+
+			// when
+			err := uow.Do(t.Context(), func(tx Repositories) error {
+				if _, err := tx.Enrollments.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled}); err != nil {
+					return err
+				}
+				return tx.Lectures.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0)
+			})
+
+			// then
+			count, _ := repos.Enrollments.CountByLectureID(t.Context(), 1001)
+			lecture, _ := repos.Lectures.FindByID(t.Context(), 1001)
+			if err != nil || count != 1 || lecture.CurrentEnrollment != 1 {
+				t.Errorf("기대 : (1, 1), 결과 : (%d, %d) %v", count, lecture.CurrentEnrollment, err)
+			}
+		})
+
+		t.Run(name+" : 실패 시 롤백", func(t *testing.T) {
+			// given
+			uow, repos := setup(t)
+			failure := errors.New("수강 인원 갱신 실패")
+
+			// when
+			err := uow.Do(t.Context(), func(tx Repositories) error {
+				if _, err := tx.Enrollments.Create(t.Context(), model.Enrollment{StudentID: 2001, LectureID: 1001, TermID: constants.DefaultTermID, Status: model.EnrollmentStatusEnrolled}); err != nil {
+					return err
+				}
+				if err := tx.Lectures.UpdateCurrentEnrollment(t.Context(), 1001, 1, 0); err != nil {
+					return err
+				}
+				return failure
+			})
+
+			// then
+			count, _ := repos.Enrollments.CountByLectureID(t.Context(), 1001)
+			lecture, _ := repos.Lectures.FindByID(t.Context(), 1001)
+			if !errors.Is(err, failure) || count != 0 || lecture.CurrentEnrollment != 0 {
+				t.Errorf("기대 : (0, 0), 결과 : (%d, %d) %v", count, lecture.CurrentEnrollment, err)
+			}
+		})
+	}
+}
+
+func TestCompensationLog(t *testing.T) {
+	t.Run("보상 작업은 역순으로 실행", func(t *testing.T) {
+		// given
+		var order []int
+		undo := &compensationLog{}
+		for i := 1; i <= 3; i++ {
+			step := i
+			undo.record(func() error {
+				order = append(order, step)
+				return nil
+			})
+		}
+
+		// when
+		_ = undo.rollback()
+
+		// then
+		if len(order) != 3 || order[0] != 3 || order[2] != 1 {
+			t.Errorf("기대 : [3 2 1], 결과 : %v", order)
+		}
+	})
+}
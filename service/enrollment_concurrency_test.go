@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEnrollmentServiceConcurrency(t *testing.T) {
+	t.Run("동시 신청 시 최대 수강 학점 유지", func(t *testing.T) {
+		// given
+		service, store := newConcurrentEnrollmentService(t)
+		days := []model.Day{model.Monday, model.Tuesday, model.Wednesday, model.Thursday, model.Friday}
+		var lectureIDs []int
+		for i, day := range days {
+			for j, slot := range [][2]string{{"09:00", "10:30"}, {"13:00", "14:30"}} {
+				id := 2001 + i*2 + j
+				lecture, _ := model.NewLecture(id, "강좌"+string(rune('A'+i*2+j)), 30, 6, day, slot[0], slot[1])
+				_, _ = repository.NewMemoryLectureRepository(store).Create(t.Context(), *lecture)
+				lectureIDs = append(lectureIDs, id)
+			}
+		}
+		studentIDs := []int{1001, 1002, 1003, 1004, 1005}
+		for _, id := range studentIDs {
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: id})
+		}
+
+		// when
+		var wg sync.WaitGroup
+		for _, studentID := range studentIDs {
+			for _, lectureID := range lectureIDs {
+				wg.Add(1)
+				go func(studentID, lectureID int) {
+					defer wg.Done()
+					_, _ = service.Enroll(t.Context(), studentID, lectureID)
+				}(studentID, lectureID)
+			}
+		}
+		wg.Wait()
+
+		// then
+		enrollmentRepo := repository.NewMemoryEnrollmentRepository(store)
+		for _, studentID := range studentIDs {
+			lectures, _ := enrollmentRepo.FindLecturesByStudent(t.Context(), studentID, "")
+			credits := 0
+			for _, lecture := range lectures {
+				credits += lecture.Credit
+			}
+			if credits != constants.TotalCreditLimit {
+				t.Errorf("학생 %d 기대 : %d학점, 결과 : %d학점", studentID, constants.TotalCreditLimit, credits)
+			}
+		}
+	})
+
+	t.Run("동시 신청 시 시간 충돌 검사 유지", func(t *testing.T) {
+		// given
+		service, store := newConcurrentEnrollmentService(t)
+		var lectureIDs []int
+		for i := 0; i < 10; i++ {
+			id := 2001 + i
+			lecture, _ := model.NewLecture(id, "강좌"+string(rune('A'+i)), 30, 3, model.Monday, "09:00", "10:30")
+			_, _ = repository.NewMemoryLectureRepository(store).Create(t.Context(), *lecture)
+			lectureIDs = append(lectureIDs, id)
+		}
+		_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1001})
+
+		// when
+		var wg sync.WaitGroup
+		for _, lectureID := range lectureIDs {
+			wg.Add(1)
+			go func(lectureID int) {
+				defer wg.Done()
+				_, _ = service.Enroll(t.Context(), 1001, lectureID)
+			}(lectureID)
+		}
+		wg.Wait()
+
+		// then
+		enrollments, _ := repository.NewMemoryEnrollmentRepository(store).FindByStudent(t.Context(), 1001)
+		if len(enrollments) != 1 {
+			t.Errorf("기대 : 1, 결과 : %d", len(enrollments))
+		}
+	})
+
+	t.Run("대기 승격과 승격 대상 학생의 신청이 겹쳐도 최대 수강 학점 유지", func(t *testing.T) {
+		for attempt := 0; attempt < 20; attempt++ {
+			// given
+			// 강좌 2001(정원 1명)은 1001이 수강 중이고 15학점을 신청한 1002가 대기 1순위, 강좌 2010은 빈자리 있음
+			service, store := newConcurrentEnrollmentService(t)
+			lectureRepo := repository.NewMemoryLectureRepository(store)
+			full, _ := model.NewLecture(2001, "데이터베이스", 1, 3, model.Monday, "09:00", "10:30")
+			open, _ := model.NewLecture(2010, "운영체제", 30, 3, model.Friday, "15:00", "16:30")
+			_, _ = lectureRepo.Create(t.Context(), *full)
+			_, _ = lectureRepo.Create(t.Context(), *open)
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1001})
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1002})
+			_, _ = service.Enroll(t.Context(), 1001, 2001)
+			for i, day := range []model.Day{model.Tuesday, model.Wednesday, model.Thursday, model.Friday, model.Monday} {
+				lecture, _ := model.NewLecture(2002+i, "강좌"+string(rune('A'+i)), 30, 3, day, "13:00", "14:30")
+				_, _ = lectureRepo.Create(t.Context(), *lecture)
+				_, _ = service.Enroll(t.Context(), 1002, lecture.ID)
+			}
+			_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+
+			// when
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_ = service.Cancel(t.Context(), 1001, 2001)
+			}()
+			go func() {
+				defer wg.Done()
+				_, _ = service.Enroll(t.Context(), 1002, 2010)
+			}()
+			wg.Wait()
+
+			// then
+			lectures, _ := repository.NewMemoryEnrollmentRepository(store).FindLecturesByStudent(t.Context(), 1002, "")
+			credits := 0
+			for _, lecture := range lectures {
+				credits += lecture.Credit
+			}
+			if credits > constants.TotalCreditLimit {
+				t.Fatalf("기대 : %d학점 이하, 결과 : %d학점 (%d번째 시도)", constants.TotalCreditLimit, credits, attempt+1)
+			}
+		}
+	})
+
+	t.Run("강좌 시간 변경과 수강생의 다른 강좌 신청이 겹쳐도 시간 충돌 없음", func(t *testing.T) {
+		for attempt := 0; attempt < 20; attempt++ {
+			// given
+			// 1001은 강좌 2001(월 09:00)을 수강 중, 2001을 화 09:00으로 옮기는 동시에 화 09:00 강좌 2002를 신청
+			enrollmentService, lectureService, store := newConcurrentServices(t)
+			lectureRepo := repository.NewMemoryLectureRepository(store)
+			moved, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			other, _ := model.NewLecture(2002, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+			_, _ = lectureRepo.Create(t.Context(), *moved)
+			_, _ = lectureRepo.Create(t.Context(), *other)
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1001})
+			_, _ = enrollmentService.Enroll(t.Context(), 1001, 2001)
+
+			// when
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, _ = lectureService.Update(t.Context(), 2001, dto.UpdateLectureRequest{
+					Slots: []dto.MeetingSlotRequest{{Day: model.Tuesday, StartTime: "09:00", EndTime: "10:30"}},
+				})
+			}()
+			go func() {
+				defer wg.Done()
+				_, _ = enrollmentService.Enroll(t.Context(), 1001, 2002)
+			}()
+			wg.Wait()
+
+			// then
+			lectures, _ := repository.NewMemoryEnrollmentRepository(store).FindLecturesByStudent(t.Context(), 1001, "")
+			if len(lectures) == 2 && lectures[0].HasTimeConflict(&lectures[1]) {
+				t.Fatalf("기대 : 시간 충돌 없음, 결과 : %s와 %s가 겹침 (%d번째 시도)", lectures[0].Name, lectures[1].Name, attempt+1)
+			}
+		}
+	})
+}
+
+// newConcurrentEnrollmentService 트랜잭션 없이 저장소 연산 단위로만 원자적인 환경에서
+// 서비스 잠금만으로 학생 단위 검사가 보장되는지 확인하기 위한 서비스
+// 학생의 수강 목록 조회를 지연시켜 검사와 생성 사이의 경쟁 구간을 넓힘
+func newConcurrentEnrollmentService(t *testing.T) (EnrollmentService, *repository.MemoryStore) {
+	t.Helper()
+	service, _, store := newConcurrentServices(t)
+	return service, store
+}
+
+// newConcurrentServices 같은 잠금 관리자와 지연된 수강신청 저장소를 쓰는 수강신청/강좌 서비스
+func newConcurrentServices(t *testing.T) (EnrollmentService, LectureService, *repository.MemoryStore) {
+	t.Helper()
+	store := repository.NewMemoryStore()
+	locks := lock.NewMemoryLockManager()
+	repos := repository.Repositories{
+		Lectures:    repository.NewMemoryLectureRepository(store),
+		Enrollments: &slowEnrollmentRepository{repository.NewMemoryEnrollmentRepository(store)},
+		Students:    repository.NewMemoryStudentRepository(store),
+	}
+	enrollmentService := NewEnrollmentServiceWithUnitOfWork(
+		repository.NewPassThroughUnitOfWork(repos),
+		repos.Enrollments,
+		locks,
+		constants.LockTimeoutDefault,
+	)
+	lectureService := NewLectureServiceWithLocks(
+		repos.Lectures, repos.Enrollments, nil, nil, nil,
+		locks, constants.LockTimeoutDefault, model.DefaultSchedulePolicy(), "")
+	return enrollmentService, lectureService, store
+}
+
+type slowEnrollmentRepository struct {
+	repository.EnrollmentRepository
+}
+
+// FindLecturesByStudent 읽은 뒤에 지연하여 검사에 쓴 목록이 생성 시점까지 낡은 상태로 남게 함
+func (r *slowEnrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
+	lectures, err := r.EnrollmentRepository.FindLecturesByStudent(ctx, studentID, termID)
+	time.Sleep(time.Millisecond)
+	return lectures, err
+}
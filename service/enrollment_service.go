@@ -1,117 +1,237 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
 	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/infrastructure/resilience"
 	"golang-course-registration/model"
 	"golang-course-registration/repository"
-	"sync"
+	"log"
+	"maps"
+	"slices"
+	"time"
 )
 
 type EnrollmentService interface {
-	Enroll(studentID, lectureID int) (dto.EnrollmentResponse, error)
-	Cancel(studentID, lectureID int) error
-	ListByStudent(studentID int) ([]dto.LectureResponse, error)
+	Enroll(ctx context.Context, studentID, lectureID int) (dto.EnrollmentResponse, error)
+	Cancel(ctx context.Context, studentID, lectureID int) error
+	// ListByStudent termID가 비어 있으면 현재 학기
+	ListByStudent(ctx context.Context, studentID int, termID string) ([]dto.LectureResponse, error)
+	// History termID가 비어 있으면 현재 학기
+	History(ctx context.Context, studentID int, termID string, statuses ...model.EnrollmentStatus) ([]dto.EnrollmentResponse, error)
+	JoinWaitlist(ctx context.Context, studentID, lectureID int) (dto.WaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, studentID, lectureID int) error
+	WaitlistPosition(ctx context.Context, studentID, lectureID int) (dto.WaitlistResponse, error)
+	// PromoteWaitlist 강좌의 빈자리를 대기 순서대로 승격 (정원이 늘었거나 수강 인원을 보정한 경우)
+	PromoteWaitlist(ctx context.Context, lectureID int) error
+	LockStats() []lock.Stat
 }
 
 type enrollmentService struct {
+	uow            repository.UnitOfWork
 	enrollmentRepo repository.EnrollmentRepository
-	lectureRepo    repository.LectureRepository
-	studentRepo    repository.StudentRepository
-	lectureLocks   map[int]*sync.Mutex
-	locksMutex     sync.Mutex
+	locks          lock.LockManager
+	lockTimeout    time.Duration
+	activeTerm     string
+	now            func() time.Time
 }
 
 func NewEnrollmentService(
 	enrollmentRepo repository.EnrollmentRepository,
 	lectureRepo repository.LectureRepository,
 	studentRepo repository.StudentRepository,
+) EnrollmentService {
+	uow := repository.NewPassThroughUnitOfWork(repository.Repositories{
+		Lectures:    lectureRepo,
+		Enrollments: enrollmentRepo,
+		Students:    studentRepo,
+	})
+	return NewEnrollmentServiceWithUnitOfWork(uow, enrollmentRepo, lock.NewMemoryLockManager(), constants.LockTimeoutDefault)
+}
+
+// NewEnrollmentServiceWithUnitOfWork 수강신청/취소를 작업 단위로 묶어 커밋 또는 롤백하고,
+// 강좌별 잠금은 locks에서 lockTimeout 안에 획득
+func NewEnrollmentServiceWithUnitOfWork(
+	uow repository.UnitOfWork,
+	enrollmentRepo repository.EnrollmentRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+) EnrollmentService {
+	return NewEnrollmentServiceWithTerm(uow, enrollmentRepo, locks, lockTimeout, "")
+}
+
+// NewEnrollmentServiceWithTerm activeTerm 학기의 강좌만 수강신청/대기 등록을 받음 (비어 있으면 학기 제한 없음)
+func NewEnrollmentServiceWithTerm(
+	uow repository.UnitOfWork,
+	enrollmentRepo repository.EnrollmentRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+	activeTerm string,
 ) EnrollmentService {
 	return &enrollmentService{
+		uow:            uow,
 		enrollmentRepo: enrollmentRepo,
-		lectureRepo:    lectureRepo,
-		studentRepo:    studentRepo,
-		lectureLocks:   make(map[int]*sync.Mutex),
+		locks:          locks,
+		lockTimeout:    lockTimeout,
+		activeTerm:     activeTerm,
+		now:            time.Now,
 	}
 }
 
 // Enroll 수강신청
-func (s *enrollmentService) Enroll(studentID, lectureID int) (dto.EnrollmentResponse, error) {
-	lectureLock := s.getLectureLock(lectureID)
-	lectureLock.Lock()
-	defer lectureLock.Unlock()
-
-	lecture, err := s.validateEnrollment(studentID, lectureID)
+func (s *enrollmentService) Enroll(ctx context.Context, studentID, lectureID int) (dto.EnrollmentResponse, error) {
+	release, err := s.acquireLocks(ctx, studentID, lectureID)
 	if err != nil {
 		return dto.EnrollmentResponse{}, err
 	}
-
-	if err := s.checkTimeConflict(studentID, lecture); err != nil {
-		return dto.EnrollmentResponse{}, err
-	}
-
-	if err := s.checkCreditLimit(studentID, lecture); err != nil {
+	defer release()
+
+	var response dto.EnrollmentResponse
+	err = retryOnConflict(func() error {
+		return s.uow.Do(ctx, func(repos repository.Repositories) error {
+			lecture, err := s.validateEnrollment(ctx, repos, studentID, lectureID)
+			if err != nil {
+				return err
+			}
+
+			if err := s.checkTimeConflict(ctx, repos, studentID, lecture); err != nil {
+				return err
+			}
+
+			if err := s.checkCreditLimit(ctx, repos, studentID, lecture); err != nil {
+				return err
+			}
+
+			response, err = s.createEnrollment(ctx, repos, studentID, lecture)
+			return err
+		})
+	})
+	if err != nil {
 		return dto.EnrollmentResponse{}, err
 	}
 
-	return s.createEnrollment(studentID, lectureID)
+	return response, nil
 }
 
 // ListByStudent 학생 수강신청 내역 조회
-func (s *enrollmentService) ListByStudent(studentID int) ([]dto.LectureResponse, error) {
-	lectures, err := s.enrollmentRepo.FindLecturesByStudent(studentID)
+func (s *enrollmentService) ListByStudent(ctx context.Context, studentID int, termID string) ([]dto.LectureResponse, error) {
+	termID, err := resolveTerm(termID, s.activeTerm)
+	if err != nil {
+		return nil, err
+	}
+
+	lectures, err := s.enrollmentRepo.FindLecturesByStudent(ctx, studentID, termID)
 	if err != nil {
 		return nil, err
 	}
 
 	lectureList := make([]dto.LectureResponse, 0, len(lectures))
 	for _, lecture := range lectures {
-		response := dto.NewLectureResponse(lecture)
+		response := dto.NewLectureResponse(lecture, i18n.FromContext(ctx))
 		lectureList = append(lectureList, response)
 	}
 
 	return lectureList, nil
 }
 
-// validateEnrollment 학생 및 강좌 존재 여부, 정원 체크
-func (s *enrollmentService) validateEnrollment(studentID, lectureID int) (model.Lecture, error) {
-	if _, err := s.studentRepo.FindByID(studentID); err != nil {
-		return model.Lecture{}, errors.New(exception.ErrStudentNotFound)
+// History 학생의 한 학기 수강신청 이력 (최근 순), statuses를 주면 해당 상태만 조회
+func (s *enrollmentService) History(ctx context.Context, studentID int, termID string, statuses ...model.EnrollmentStatus) ([]dto.EnrollmentResponse, error) {
+	termID, err := resolveTerm(termID, s.activeTerm)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.enrollmentRepo.FindByStudent(ctx, studentID, statuses...)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]dto.EnrollmentResponse, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		if termID != "" && enrollment.TermID != termID {
+			continue
+		}
+		history = append(history, dto.NewEnrollmentResponse(enrollment))
+	}
+	return history, nil
+}
+
+// validateEnrollment 학생 및 강좌 존재 여부, 강좌 학기, 정원, 대기 순서 체크
+func (s *enrollmentService) validateEnrollment(ctx context.Context, repos repository.Repositories, studentID, lectureID int) (model.Lecture, error) {
+	if _, err := repos.Students.FindByID(ctx, studentID); err != nil {
+		return model.Lecture{}, notFoundError(err, exception.ErrStudentNotFound)
 	}
 
-	lecture, err := s.lectureRepo.FindByID(lectureID)
+	lecture, err := repos.Lectures.FindByID(ctx, lectureID)
 	if err != nil {
-		return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+		return model.Lecture{}, notFoundError(err, exception.ErrLectureNotFound)
+	}
+
+	if err := s.checkActiveTerm(lecture); err != nil {
+		return model.Lecture{}, err
 	}
 
 	if lecture.IsFull() {
-		return model.Lecture{}, errors.New(exception.ErrLectureCapacityExceeded)
+		return model.Lecture{}, exception.ErrLectureCapacityExceeded
+	}
+
+	if err := s.checkWaitlistOrder(ctx, repos, studentID, lecture); err != nil {
+		return model.Lecture{}, err
 	}
 
 	return lecture, nil
 }
 
-// checkTimeConflict 기존 수강신청과 시간 충돌 체크
-func (s *enrollmentService) checkTimeConflict(studentID int, newLecture model.Lecture) error {
-	existingLectures, err := s.enrollmentRepo.FindLecturesByStudent(studentID)
+// checkWaitlistOrder 대기 학생이 있으면 빈자리는 대기 순서대로 채우므로, 대기 순번이 빈자리 수 안에 들어야 신청 가능
+// (승격이 대기 1순위 학생의 요청이 끝나기를 기다리는 동안 대기하지 않은 학생이 먼저 신청하지 않도록)
+func (s *enrollmentService) checkWaitlistOrder(ctx context.Context, repos repository.Repositories, studentID int, lecture model.Lecture) error {
+	waitlist, err := repos.Enrollments.FindByLecture(ctx, lecture.ID, model.EnrollmentStatusWaitlisted)
+	if err != nil {
+		return err
+	}
+	if len(waitlist) == 0 {
+		return nil
+	}
+
+	position := model.WaitlistPosition(waitlist, studentID)
+	if position == 0 || position > lecture.Capacity-lecture.CurrentEnrollment {
+		return exception.ErrLectureCapacityExceeded
+	}
+	return nil
+}
+
+// checkActiveTerm 현재 학기가 정해져 있으면 그 학기의 강좌만 허용
+func (s *enrollmentService) checkActiveTerm(lecture model.Lecture) error {
+	if s.activeTerm != "" && lecture.TermID != s.activeTerm {
+		return exception.ErrLectureTermNotActive
+	}
+	return nil
+}
+
+// checkTimeConflict 같은 학기의 기존 수강신청과 시간 충돌 체크
+func (s *enrollmentService) checkTimeConflict(ctx context.Context, repos repository.Repositories, studentID int, newLecture model.Lecture) error {
+	existingLectures, err := repos.Enrollments.FindLecturesByStudent(ctx, studentID, newLecture.TermID)
 	if err != nil {
 		return err
 	}
 
 	for _, existingLecture := range existingLectures {
 		if existingLecture.HasTimeConflict(&newLecture) {
-			return errors.New(exception.TimeConflictMessage(existingLecture.Name))
+			return exception.TimeConflict(existingLecture.Name)
 		}
 	}
 
 	return nil
 }
 
-// checkCreditLimit 총 학점이 18학점을 초과하지 않는지 체크
-func (s *enrollmentService) checkCreditLimit(studentID int, newLecture model.Lecture) error {
-	existingLectures, err := s.enrollmentRepo.FindLecturesByStudent(studentID)
+// checkCreditLimit 학기 총 학점이 18학점을 초과하지 않는지 체크
+func (s *enrollmentService) checkCreditLimit(ctx context.Context, repos repository.Repositories, studentID int, newLecture model.Lecture) error {
+	existingLectures, err := repos.Enrollments.FindLecturesByStudent(ctx, studentID, newLecture.TermID)
 	if err != nil {
 		return err
 	}
@@ -122,72 +242,431 @@ func (s *enrollmentService) checkCreditLimit(studentID int, newLecture model.Lec
 	}
 
 	if totalCredit > constants.TotalCreditLimit {
-		return errors.New(exception.ErrCreditLimitExceeded)
+		return exception.ErrCreditLimitExceeded
 	}
 
 	return nil
 }
 
-// createEnrollment 수강신청 생성 및 현재 수강 인원 증가
-func (s *enrollmentService) createEnrollment(studentID, lectureID int) (dto.EnrollmentResponse, error) {
-	enrollment, err := model.NewEnrollment(studentID, lectureID)
+// createEnrollment 수강신청 생성(대기 중이었다면 승격) 및 현재 수강 인원 증가 (검증 시 읽은 강좌 버전 기준)
+func (s *enrollmentService) createEnrollment(ctx context.Context, repos repository.Repositories, studentID int, lecture model.Lecture) (dto.EnrollmentResponse, error) {
+	createdEnrollment, err := s.activateEnrollment(ctx, repos, studentID, lecture)
 	if err != nil {
 		return dto.EnrollmentResponse{}, err
 	}
 
-	createdEnrollment, err := s.enrollmentRepo.Create(*enrollment)
-	if err != nil {
+	expectedVersion := lecture.Version
+	lecture.IncrementCurrentEnrollment()
+	if err := repos.Lectures.UpdateCurrentEnrollment(ctx, lecture.ID, lecture.CurrentEnrollment, expectedVersion); err != nil {
 		return dto.EnrollmentResponse{}, err
 	}
 
-	lecture, err := s.lectureRepo.FindByID(lectureID)
+	return dto.NewEnrollmentResponse(createdEnrollment), nil
+}
+
+// activateEnrollment 대기 중인 수강신청이 있으면 승격하고, 없으면 새로 생성
+func (s *enrollmentService) activateEnrollment(ctx context.Context, repos repository.Repositories, studentID int, lecture model.Lecture) (model.Enrollment, error) {
+	waiting, found, err := findEnrollment(ctx, repos, studentID, lecture.ID, model.EnrollmentStatusWaitlisted)
 	if err != nil {
-		return dto.EnrollmentResponse{}, err
+		return model.Enrollment{}, err
 	}
-	lecture.IncrementCurrentEnrollment()
-	if err := s.lectureRepo.UpdateCurrentEnrollment(lectureID, lecture.CurrentEnrollment); err != nil {
-		return dto.EnrollmentResponse{}, err
+	if found {
+		return s.changeStatus(ctx, repos, waiting, waiting.Promote)
 	}
 
-	return dto.NewEnrollmentResponse(createdEnrollment), nil
+	enrollment, err := model.NewEnrollment(studentID, lecture.ID, lecture.TermID, s.now())
+	if err != nil {
+		return model.Enrollment{}, err
+	}
+	return repos.Enrollments.Create(ctx, *enrollment)
+}
+
+// changeStatus 상태 전이(transition)를 현재 시각으로 적용해 저장
+func (s *enrollmentService) changeStatus(
+	ctx context.Context,
+	repos repository.Repositories,
+	enrollment model.Enrollment,
+	transition func(at time.Time) (model.Enrollment, error),
+) (model.Enrollment, error) {
+	changed, err := transition(s.now())
+	if err != nil {
+		return model.Enrollment{}, err
+	}
+	if err := repos.Enrollments.UpdateStatus(ctx, changed, enrollment.Status); err != nil {
+		return model.Enrollment{}, err
+	}
+	return changed, nil
+}
+
+// findEnrollment 학생이 강좌에 가진 status 상태의 수강신청
+func findEnrollment(ctx context.Context, repos repository.Repositories, studentID, lectureID int, status model.EnrollmentStatus) (model.Enrollment, bool, error) {
+	enrollments, err := repos.Enrollments.FindByStudent(ctx, studentID, status)
+	if err != nil {
+		return model.Enrollment{}, false, err
+	}
+	for _, enrollment := range enrollments {
+		if enrollment.LectureID == lectureID {
+			return enrollment, true, nil
+		}
+	}
+	return model.Enrollment{}, false, nil
+}
+
+// Cancel 수강신청 취소(DROPPED로 변경, 이력은 유지), 생긴 빈자리는 같은 잠금과 작업 단위 안에서 대기 순서대로 승격
+// 대기 1순위 학생이 다른 요청 중이라 승격을 멈췄으면 잠금을 놓은 뒤 그 학생의 요청이 끝나기를 기다려 이어서 승격
+func (s *enrollmentService) Cancel(ctx context.Context, studentID, lectureID int) error {
+	blocked, err := s.cancel(ctx, studentID, lectureID)
+	if err != nil || blocked == 0 {
+		return err
+	}
+
+	// 취소는 이미 저장되었으므로 이어서 하는 승격에 실패해도 취소는 성공으로 응답하고, 남은 대기는 다음 빈자리 때 승격
+	if err := s.resumePromotion(ctx, lectureID, blocked); err != nil {
+		log.Printf("대기 승격 실패 : 강좌 %d (%v)", lectureID, err)
+	}
+	return nil
+}
+
+// cancel 학생 → 강좌 잠금을 쥔 채 취소하고 승격, 승격을 멈추게 한 대기 1순위 학생의 학번을 반환 (멈추지 않았으면 0)
+func (s *enrollmentService) cancel(ctx context.Context, studentID, lectureID int) (int, error) {
+	release, err := s.acquireLocks(ctx, studentID, lectureID)
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	var blocked int
+	err = retryOnConflict(func() error {
+		promoted := studentLocks{}
+		defer promoted.release()
+
+		return s.uow.Do(ctx, func(repos repository.Repositories) error {
+			if _, err := repos.Students.FindByID(ctx, studentID); err != nil {
+				return notFoundError(err, exception.ErrStudentNotFound)
+			}
+
+			lecture, err := repos.Lectures.FindByID(ctx, lectureID)
+			if err != nil {
+				return notFoundError(err, exception.ErrLectureNotFound)
+			}
+
+			enrollment, found, err := findEnrollment(ctx, repos, studentID, lectureID, model.EnrollmentStatusEnrolled)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return exception.ErrEnrollmentNotFound
+			}
+			if _, err := s.changeStatus(ctx, repos, enrollment, enrollment.Drop); err != nil {
+				return err
+			}
+
+			expectedVersion := lecture.Version
+			lecture.DecrementCurrentEnrollment()
+			if err := repos.Lectures.UpdateCurrentEnrollment(ctx, lectureID, lecture.CurrentEnrollment, expectedVersion); err != nil {
+				return err
+			}
+			lecture.Version = expectedVersion + 1
+
+			blocked, err = s.promoteWaitlist(ctx, repos, lecture, promoted)
+			return err
+		})
+	})
+	return blocked, err
 }
 
-// Cancel 수강신청 취소
-func (s *enrollmentService) Cancel(studentID, lectureID int) error {
-	lectureLock := s.getLectureLock(lectureID)
-	lectureLock.Lock()
-	defer lectureLock.Unlock()
+// PromoteWaitlist 강좌의 빈자리를 대기 순서대로 승격, 취소와 같은 규칙으로 검사
+func (s *enrollmentService) PromoteWaitlist(ctx context.Context, lectureID int) error {
+	return s.resumePromotion(ctx, lectureID, 0)
+}
 
-	if _, err := s.studentRepo.FindByID(studentID); err != nil {
-		return errors.New(exception.ErrStudentNotFound)
+// resumePromotion 강좌 잠금을 획득하여 승격하고, 대기 1순위 학생(blocked)이 다른 요청 중이라 멈추면
+// 강좌 잠금을 놓은 채 학생 → 강좌 순서로 그 학생의 잠금부터 기다려 얻은 뒤 다시 승격 (뒤 순번이 앞지르지 않음)
+// 빈자리가 있는 강좌에는 대기할 수 없으므로 반복할 때마다 승격하거나 대기에서 제외되어 끝남
+func (s *enrollmentService) resumePromotion(ctx context.Context, lectureID int, blocked int) error {
+	for {
+		var err error
+		blocked, err = s.promoteWaiting(ctx, lectureID, blocked)
+		if err != nil || blocked == 0 {
+			return err
+		}
 	}
+}
 
-	lecture, err := s.lectureRepo.FindByID(lectureID)
+// promoteWaiting waitFor 학생의 잠금(0이면 없음)과 강좌 잠금을 차례로 기다려 얻은 뒤 승격
+func (s *enrollmentService) promoteWaiting(ctx context.Context, lectureID int, waitFor int) (int, error) {
+	promoted := studentLocks{}
+	defer promoted.release()
+
+	if waitFor != 0 {
+		release, err := s.locks.Acquire(ctx, lock.StudentKey(waitFor), s.lockTimeout)
+		if err != nil {
+			return 0, err
+		}
+		promoted[waitFor] = release
+	}
+
+	release, err := s.locks.Acquire(ctx, lock.LectureKey(lectureID), s.lockTimeout)
 	if err != nil {
-		return errors.New(exception.ErrLectureNotFound)
+		return 0, err
 	}
+	defer release()
+
+	var blocked int
+	err = retryOnConflict(func() error {
+		return s.uow.Do(ctx, func(repos repository.Repositories) error {
+			lecture, err := repos.Lectures.FindByID(ctx, lectureID)
+			if err != nil {
+				return notFoundError(err, exception.ErrLectureNotFound)
+			}
+			blocked, err = s.promoteWaitlist(ctx, repos, lecture, promoted)
+			return err
+		})
+	})
+	return blocked, err
+}
 
-	if err := s.enrollmentRepo.DeleteByStudentAndLecture(studentID, lectureID); err != nil {
+// promoteWaitlist 정원이 찰 때까지 대기 순서대로 수강신청으로 승격
+// 학점 제한이나 시간 충돌로 신청할 수 없는 학생은 대기에서 제외(WITHDRAWN)하고 다음 학생을 승격
+// 승격 대상 학생의 잠금은 강좌 잠금을 쥔 채 얻으므로 기다리지 않고 한 번만 시도 (기다리면 학생 → 강좌 순서를 어겨 교착 상태가 될 수 있음)
+// 그 학생이 다른 수강신청/취소 중이면 대기 순서를 지키기 위해 승격을 멈추고 그 학생의 학번을 반환
+// 얻은 잠금은 promoted에 담아 작업 단위가 끝난 뒤 해제
+func (s *enrollmentService) promoteWaitlist(ctx context.Context, repos repository.Repositories, lecture model.Lecture, promoted studentLocks) (int, error) {
+	waitlist, err := repos.Enrollments.FindByLecture(ctx, lecture.ID, model.EnrollmentStatusWaitlisted)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range waitlist {
+		if lecture.IsFull() {
+			return 0, nil
+		}
+
+		locked, err := s.tryLockStudent(ctx, promoted, entry.StudentID)
+		if err != nil {
+			return 0, err
+		}
+		if !locked {
+			return entry.StudentID, nil
+		}
+
+		err = s.checkTimeConflict(ctx, repos, entry.StudentID, lecture)
+		if err == nil {
+			err = s.checkCreditLimit(ctx, repos, entry.StudentID, lecture)
+		}
+		if errors.Is(err, exception.ErrTimeConflict) || errors.Is(err, exception.ErrCreditLimitExceeded) {
+			if _, err := s.changeStatus(ctx, repos, entry, entry.Withdraw); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err := s.createEnrollment(ctx, repos, entry.StudentID, lecture); err != nil {
+			return 0, err
+		}
+		lecture.IncrementCurrentEnrollment()
+		lecture.Version++
+	}
+	return 0, nil
+}
+
+// tryLockStudent 이미 쥔 학생 잠금은 그대로 쓰고, 아니면 기다리지 않고 한 번만 시도하여 얻으면 held에 추가
+func (s *enrollmentService) tryLockStudent(ctx context.Context, held studentLocks, studentID int) (bool, error) {
+	if _, ok := held[studentID]; ok {
+		return true, nil
+	}
+
+	release, err := s.locks.Acquire(ctx, lock.StudentKey(studentID), 0)
+	if errors.Is(err, lock.ErrTimeout) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	held[studentID] = release
+	return true, nil
+}
+
+// JoinWaitlist 정원이 찬 강좌의 대기 목록 끝에 등록
+func (s *enrollmentService) JoinWaitlist(ctx context.Context, studentID, lectureID int) (dto.WaitlistResponse, error) {
+	release, err := s.acquireLocks(ctx, studentID, lectureID)
+	if err != nil {
+		return dto.WaitlistResponse{}, err
+	}
+	defer release()
+
+	var response dto.WaitlistResponse
+	err = s.uow.Do(ctx, func(repos repository.Repositories) error {
+		if _, err := repos.Students.FindByID(ctx, studentID); err != nil {
+			return notFoundError(err, exception.ErrStudentNotFound)
+		}
+
+		lecture, err := repos.Lectures.FindByID(ctx, lectureID)
+		if err != nil {
+			return notFoundError(err, exception.ErrLectureNotFound)
+		}
+
+		if err := s.checkActiveTerm(lecture); err != nil {
+			return err
+		}
+
+		enrolled, err := repos.Enrollments.FindLecturesByStudent(ctx, studentID, lecture.TermID)
+		if err != nil {
+			return err
+		}
+		for _, enrolledLecture := range enrolled {
+			if enrolledLecture.ID == lectureID {
+				return exception.ErrWaitlistAlreadyEnrolled
+			}
+		}
+
+		if !lecture.IsFull() {
+			return exception.ErrWaitlistLectureNotFull
+		}
+
+		_, waiting, err := findEnrollment(ctx, repos, studentID, lectureID, model.EnrollmentStatusWaitlisted)
+		if err != nil {
+			return err
+		}
+		if waiting {
+			return exception.ErrWaitlistDuplicate
+		}
+
+		entry, err := model.NewWaitlistedEnrollment(studentID, lectureID, lecture.TermID, s.now())
+		if err != nil {
+			return err
+		}
+		if _, err := repos.Enrollments.Create(ctx, *entry); err != nil {
+			return err
+		}
+
+		response, err = s.waitlistPosition(ctx, repos, studentID, lectureID)
 		return err
+	})
+	if err != nil {
+		return dto.WaitlistResponse{}, err
 	}
 
-	lecture.DecrementCurrentEnrollment()
-	if err := s.lectureRepo.UpdateCurrentEnrollment(lectureID, lecture.CurrentEnrollment); err != nil {
+	return response, nil
+}
+
+// LeaveWaitlist 대기 취소(WITHDRAWN으로 변경), 강좌 잠금으로 진행 중인 승격과 겹치지 않게 함
+func (s *enrollmentService) LeaveWaitlist(ctx context.Context, studentID, lectureID int) error {
+	release, err := s.acquireLocks(ctx, studentID, lectureID)
+	if err != nil {
 		return err
 	}
+	defer release()
 
-	return nil
+	return s.uow.Do(ctx, func(repos repository.Repositories) error {
+		entry, found, err := findEnrollment(ctx, repos, studentID, lectureID, model.EnrollmentStatusWaitlisted)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return exception.ErrWaitlistNotFound
+		}
+		_, err = s.changeStatus(ctx, repos, entry, entry.Withdraw)
+		return err
+	})
+}
+
+// WaitlistPosition 대기 순번과 전체 대기 인원 조회
+func (s *enrollmentService) WaitlistPosition(ctx context.Context, studentID, lectureID int) (dto.WaitlistResponse, error) {
+	var response dto.WaitlistResponse
+	err := s.uow.Do(ctx, func(repos repository.Repositories) error {
+		var err error
+		response, err = s.waitlistPosition(ctx, repos, studentID, lectureID)
+		return err
+	})
+	if err != nil {
+		return dto.WaitlistResponse{}, err
+	}
+
+	return response, nil
 }
 
-// getLectureLock 강좌별 동기화 락 생성
-func (s *enrollmentService) getLectureLock(lectureID int) *sync.Mutex {
-	s.locksMutex.Lock()
-	defer s.locksMutex.Unlock()
+func (s *enrollmentService) waitlistPosition(ctx context.Context, repos repository.Repositories, studentID, lectureID int) (dto.WaitlistResponse, error) {
+	waitlist, err := repos.Enrollments.FindByLecture(ctx, lectureID, model.EnrollmentStatusWaitlisted)
+	if err != nil {
+		return dto.WaitlistResponse{}, err
+	}
 
-	lock, exists := s.lectureLocks[lectureID]
-	if !exists {
-		lock = &sync.Mutex{}
-		s.lectureLocks[lectureID] = lock
+	position := model.WaitlistPosition(waitlist, studentID)
+	if position == 0 {
+		return dto.WaitlistResponse{}, exception.ErrWaitlistNotFound
 	}
-	return lock
+
+	return dto.WaitlistResponse{
+		StudentID: studentID,
+		LectureID: lectureID,
+		Position:  position,
+		Waiting:   len(waitlist),
+	}, nil
+}
+
+// retryOnConflict 강좌 버전 충돌 시 작업 단위 전체를 처음부터 다시 시도
+func retryOnConflict(fn func() error) error {
+	var err error
+	for attempt := 0; attempt < constants.EnrollmentConflictMaxAttempts; attempt++ {
+		err = fn()
+
+		var conflict *repository.ConflictError
+		if !errors.As(err, &conflict) {
+			return err
+		}
+	}
+	return err
+}
+
+// notFoundError 조회 실패를 대상 없음 에러로 바꾸되, 저장소 장애와 요청 취소/마감은 그대로 전달
+func notFoundError(err error, notFound error) error {
+	if errors.Is(err, resilience.ErrUnavailable) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return notFound
+}
+
+// acquireLocks 학생 → 강좌 순서로 잠금 획득, ctx가 취소되면 대기를 멈춤
+// 학생 잠금은 학점 제한/시간 충돌 검사를, 강좌 잠금은 정원 검사를 원자적으로 만듦
+func (s *enrollmentService) acquireLocks(ctx context.Context, studentID, lectureID int) (lock.Release, error) {
+	releaseStudent, err := s.locks.Acquire(ctx, lock.StudentKey(studentID), s.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseLecture, err := s.locks.Acquire(ctx, lock.LectureKey(lectureID), s.lockTimeout)
+	if err != nil {
+		releaseStudent()
+		return nil, err
+	}
+
+	return func() {
+		releaseLecture()
+		releaseStudent()
+	}, nil
+}
+
+// studentLocks 학번별로 쥐고 있는 학생 잠금, 작업이 끝나면 함께 해제
+type studentLocks map[int]lock.Release
+
+// ids 잠근 학생의 학번 (오름차순)
+func (l studentLocks) ids() []int {
+	return slices.Sorted(maps.Keys(l))
+}
+
+func (l studentLocks) release() {
+	for _, release := range l {
+		release()
+	}
+}
+
+// LockStats 학생/강좌별 잠금 경합 통계
+func (s *enrollmentService) LockStats() []lock.Stat {
+	return s.locks.Stats()
 }
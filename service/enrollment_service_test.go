@@ -1,11 +1,16 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
+	"golang-course-registration/infrastructure/lock"
 	"golang-course-registration/model"
+	"golang-course-registration/repository"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestEnrollmentService(t *testing.T) {
@@ -20,7 +25,7 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			response, _ := service.Enroll(1001, 2001)
+			response, _ := service.Enroll(t.Context(), 1001, 2001)
 
 			// then
 			if response.StudentID != 1001 || response.LectureID != 2001 {
@@ -37,10 +42,10 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_, err := service.Enroll(1001, 2001)
+			_, err := service.Enroll(t.Context(), 1001, 2001)
 
 			// then
-			if err == nil || err.Error() != exception.ErrStudentNotFound {
+			if !errors.Is(err, exception.ErrStudentNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrStudentNotFound, err)
 			}
 		})
@@ -54,10 +59,10 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_, err := service.Enroll(1001, 2001)
+			_, err := service.Enroll(t.Context(), 1001, 2001)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureNotFound {
+			if !errors.Is(err, exception.ErrLectureNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
 			}
 		})
@@ -73,10 +78,10 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_, err := service.Enroll(1001, 2001)
+			_, err := service.Enroll(t.Context(), 1001, 2001)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureCapacityExceeded {
+			if !errors.Is(err, exception.ErrLectureCapacityExceeded) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureCapacityExceeded, err)
 			}
 		})
@@ -86,18 +91,42 @@ func TestEnrollmentService(t *testing.T) {
 			student, _ := model.NewStudent(1001)
 			existingLecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
 			newLecture, _ := model.NewLecture(2002, "운영체제", 30, 3, model.Monday, "10:00", "11:30")
-			enrollment := model.Enrollment{StudentID: 1001, LectureID: 2001}
+			enrollment := model.Enrollment{StudentID: 1001, LectureID: 2001, Status: model.EnrollmentStatusEnrolled}
 			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
 			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*existingLecture, *newLecture}}
 			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{enrollment}, lectures: []model.Lecture{*existingLecture}}
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_, err := service.Enroll(1001, 2002)
+			_, err := service.Enroll(t.Context(), 1001, 2002)
 
 			// then
-			expectedError := exception.TimeConflictMessage(existingLecture.Name)
-			if err == nil || err.Error() != expectedError {
+			expectedError := exception.TimeConflict(existingLecture.Name)
+			if !errors.Is(err, exception.ErrTimeConflict) || err.Error() != expectedError.Error() {
+				t.Errorf("기대 : %s, 결과 : %v", expectedError, err)
+			}
+		})
+
+		t.Run("예외 : 강의 시간 충돌 (여러 수업 시간 중 두 번째가 겹침)", func(t *testing.T) {
+			// given
+			student, _ := model.NewStudent(1001)
+			existingLecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			newLecture, _ := model.NewLectureWithSlots(2002, "운영체제", 30, 3, []model.MeetingSlotInput{
+				{Day: model.Tuesday, StartTime: "09:00", EndTime: "10:30"},
+				{Day: model.Monday, StartTime: "10:00", EndTime: "11:30"},
+			})
+			enrollment := model.Enrollment{StudentID: 1001, LectureID: 2001, Status: model.EnrollmentStatusEnrolled}
+			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*existingLecture, *newLecture}}
+			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{enrollment}, lectures: []model.Lecture{*existingLecture}}
+			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+
+			// when
+			_, err := service.Enroll(t.Context(), 1001, 2002)
+
+			// then
+			expectedError := exception.TimeConflict(existingLecture.Name)
+			if !errors.Is(err, exception.ErrTimeConflict) || err.Error() != expectedError.Error() {
 				t.Errorf("기대 : %s, 결과 : %v", expectedError, err)
 			}
 		})
@@ -110,7 +139,7 @@ func TestEnrollmentService(t *testing.T) {
 			for i := 0; i < 6; i++ {
 				lec, _ := model.NewLecture(2001+i, "강의"+strconv.Itoa(i), 30, 3, model.Monday, "09:00", "10:30")
 				lectures = append(lectures, *lec)
-				enrollments = append(enrollments, model.Enrollment{StudentID: 1001, LectureID: lec.ID})
+				enrollments = append(enrollments, model.Enrollment{StudentID: 1001, LectureID: lec.ID, Status: model.EnrollmentStatusEnrolled})
 			}
 			newLecture, _ := model.NewLecture(3000, "추가 강의", 30, 3, model.Friday, "11:00", "12:30")
 			lectures = append(lectures, *newLecture)
@@ -121,27 +150,119 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_, err := service.Enroll(1001, 3000)
+			_, err := service.Enroll(t.Context(), 1001, 3000)
 
 			// then
-			if err == nil || err.Error() != exception.ErrCreditLimitExceeded {
+			if !errors.Is(err, exception.ErrCreditLimitExceeded) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrCreditLimitExceeded, err)
 			}
 		})
 	})
 
+	t.Run("수강 신청 버전 충돌", func(t *testing.T) {
+		t.Run("성공 : 충돌 후 재시도", func(t *testing.T) {
+			// given
+			student, _ := model.NewStudent(1001)
+			lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}, concurrentUpdates: 1}
+			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{}, lectures: []model.Lecture{*lecture}}
+			uow := newMockUnitOfWork(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+			service := NewEnrollmentServiceWithUnitOfWork(uow, mockEnrollmentRepo, lock.NewMemoryLockManager(), constants.LockTimeoutDefault)
+
+			// when
+			_, err := service.Enroll(t.Context(), 1001, 2001)
+
+			// then
+			updatedLecture, _ := mockLectureRepo.FindByID(t.Context(), 2001)
+			if err != nil || updatedLecture.CurrentEnrollment != 1 {
+				t.Errorf("기대 : 1, 결과 : %d (%v)", updatedLecture.CurrentEnrollment, err)
+			}
+		})
+
+		t.Run("예외 : 재시도 횟수 초과", func(t *testing.T) {
+			// given
+			student, _ := model.NewStudent(1001)
+			lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}, concurrentUpdates: constants.EnrollmentConflictMaxAttempts}
+			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{}, lectures: []model.Lecture{*lecture}}
+			uow := newMockUnitOfWork(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+			service := NewEnrollmentServiceWithUnitOfWork(uow, mockEnrollmentRepo, lock.NewMemoryLockManager(), constants.LockTimeoutDefault)
+
+			// when
+			_, err := service.Enroll(t.Context(), 1001, 2001)
+
+			// then
+			var conflict *repository.ConflictError
+			if !errors.As(err, &conflict) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureVersionConflict, err)
+			}
+		})
+	})
+
+	t.Run("예외 : 강좌 잠금 획득 시간 초과", func(t *testing.T) {
+		// given
+		student, _ := model.NewStudent(1001)
+		lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+		mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}}
+		mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{}, lectures: []model.Lecture{*lecture}}
+		uow := newMockUnitOfWork(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+		locks := lock.NewMemoryLockManager()
+		service := NewEnrollmentServiceWithUnitOfWork(uow, mockEnrollmentRepo, locks, 10*time.Millisecond)
+		release, _ := locks.Acquire(t.Context(), lock.LectureKey(2001), time.Second)
+		defer release()
+
+		// when
+		_, err := service.Enroll(t.Context(), 1001, 2001)
+
+		// then
+		if !errors.Is(err, lock.ErrTimeout) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLockTimeout, err)
+		}
+	})
+
+	t.Run("예외 : 잠금 대기 중 요청 취소", func(t *testing.T) {
+		// given
+		student, _ := model.NewStudent(1001)
+		lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+		mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+		mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}}
+		mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{}, lectures: []model.Lecture{*lecture}}
+		uow := newMockUnitOfWork(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+		locks := lock.NewMemoryLockManager()
+		service := NewEnrollmentServiceWithUnitOfWork(uow, mockEnrollmentRepo, locks, time.Minute)
+		release, _ := locks.Acquire(t.Context(), lock.LectureKey(2001), time.Second)
+		defer release()
+		ctx, cancel := context.WithCancel(t.Context())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// when
+		started := time.Now()
+		_, err := service.Enroll(ctx, 1001, 2001)
+
+		// then
+		if !errors.Is(err, context.Canceled) || time.Since(started) > time.Second {
+			t.Errorf("기대 : %v, 결과 : %v (%s)", context.Canceled, err, time.Since(started))
+		}
+		if len(mockEnrollmentRepo.enrollments) != 0 {
+			t.Errorf("기대 : 0, 결과 : %d", len(mockEnrollmentRepo.enrollments))
+		}
+	})
+
 	t.Run("수강 신청 목록 조회", func(t *testing.T) {
 		// given
 		lecture1, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
 		lecture2, _ := model.NewLecture(2002, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
-		enrollments := []model.Enrollment{{StudentID: 1001, LectureID: 2001}, {StudentID: 1001, LectureID: 2002}}
+		enrollments := []model.Enrollment{{StudentID: 1001, LectureID: 2001, Status: model.EnrollmentStatusEnrolled}, {StudentID: 1001, LectureID: 2002, Status: model.EnrollmentStatusEnrolled}}
 		mockStudentRepo := &MockStudentRepositoryForService{}
 		mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture1, *lecture2}}
 		mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: enrollments, lectures: []model.Lecture{*lecture1, *lecture2}}
 		service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 		// when
-		responses, _ := service.ListByStudent(1001)
+		responses, _ := service.ListByStudent(t.Context(), 1001, "")
 
 		// then
 		if len(responses) != 2 {
@@ -155,20 +276,23 @@ func TestEnrollmentService(t *testing.T) {
 			student, _ := model.NewStudent(1001)
 			lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
 			lecture.CurrentEnrollment = 10
-			enrollment := model.Enrollment{StudentID: 1001, LectureID: 2001}
+			enrollment := model.Enrollment{StudentID: 1001, LectureID: 2001, Status: model.EnrollmentStatusEnrolled}
 			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
 			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}}
 			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{enrollment}, lectures: []model.Lecture{*lecture}}
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			_ = service.Cancel(1001, 2001)
+			_ = service.Cancel(t.Context(), 1001, 2001)
 
 			// then
-			updatedLecture, _ := mockLectureRepo.FindByID(2001)
+			updatedLecture, _ := mockLectureRepo.FindByID(t.Context(), 2001)
 			if updatedLecture.CurrentEnrollment != 9 {
 				t.Errorf("기대 : 9, 결과 : %d", updatedLecture.CurrentEnrollment)
 			}
+			if dropped := mockEnrollmentRepo.enrollments[0]; dropped.Status != model.EnrollmentStatusDropped || dropped.DroppedAt.IsZero() {
+				t.Errorf("기대 : DROPPED 이력 유지, 결과 : %+v", dropped)
+			}
 		})
 
 		t.Run("예외 : 존재하지 않는 학생", func(t *testing.T) {
@@ -180,10 +304,10 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			err := service.Cancel(1001, 2001)
+			err := service.Cancel(t.Context(), 1001, 2001)
 
 			// then
-			if err == nil || err.Error() != exception.ErrStudentNotFound {
+			if !errors.Is(err, exception.ErrStudentNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrStudentNotFound, err)
 			}
 		})
@@ -197,24 +321,74 @@ func TestEnrollmentService(t *testing.T) {
 			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
 
 			// when
-			err := service.Cancel(1001, 2001)
+			err := service.Cancel(t.Context(), 1001, 2001)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureNotFound {
+			if !errors.Is(err, exception.ErrLectureNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
 			}
 		})
+
+		t.Run("예외 : 수강신청 내역 없음 (수강 인원 유지)", func(t *testing.T) {
+			// given
+			student, _ := model.NewStudent(1001)
+			lecture, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			lecture.CurrentEnrollment = 10
+			mockStudentRepo := &MockStudentRepositoryForService{students: []model.Student{*student}}
+			mockLectureRepo := &MockLectureRepositoryForService{lectures: []model.Lecture{*lecture}}
+			mockEnrollmentRepo := &MockEnrollmentRepositoryForService{enrollments: []model.Enrollment{}, lectures: []model.Lecture{*lecture}}
+			service := NewEnrollmentService(mockEnrollmentRepo, mockLectureRepo, mockStudentRepo)
+
+			// when
+			err := service.Cancel(t.Context(), 1001, 2001)
+
+			// then
+			updatedLecture, _ := mockLectureRepo.FindByID(t.Context(), 2001)
+			if !errors.Is(err, exception.ErrEnrollmentNotFound) || updatedLecture.CurrentEnrollment != 10 {
+				t.Errorf("기대 : %s (10), 결과 : %v (%d)", exception.ErrEnrollmentNotFound, err, updatedLecture.CurrentEnrollment)
+			}
+		})
 	})
 }
 
+// MockUnitOfWork 실패 시 수강신청 목록을 작업 이전 상태로 되돌림
+type MockUnitOfWork struct {
+	repos          repository.Repositories
+	enrollmentRepo *MockEnrollmentRepositoryForService
+}
+
+func newMockUnitOfWork(
+	enrollmentRepo *MockEnrollmentRepositoryForService,
+	lectureRepo *MockLectureRepositoryForService,
+	studentRepo *MockStudentRepositoryForService,
+) *MockUnitOfWork {
+	return &MockUnitOfWork{
+		repos: repository.Repositories{
+			Lectures:    lectureRepo,
+			Enrollments: enrollmentRepo,
+			Students:    studentRepo,
+		},
+		enrollmentRepo: enrollmentRepo,
+	}
+}
+
+func (m *MockUnitOfWork) Do(ctx context.Context, fn func(repos repository.Repositories) error) error {
+	snapshot := append([]model.Enrollment(nil), m.enrollmentRepo.enrollments...)
+	if err := fn(m.repos); err != nil {
+		m.enrollmentRepo.enrollments = snapshot
+		return err
+	}
+	return nil
+}
+
 type MockEnrollmentRepositoryForService struct {
 	enrollments []model.Enrollment
 	lectures    []model.Lecture
 	createError error
-	deleteError error
+	updateError error
 }
 
-func (m *MockEnrollmentRepositoryForService) Create(enrollment model.Enrollment) (model.Enrollment, error) {
+func (m *MockEnrollmentRepositoryForService) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
 	if m.createError != nil {
 		return model.Enrollment{}, m.createError
 	}
@@ -223,22 +397,32 @@ func (m *MockEnrollmentRepositoryForService) Create(enrollment model.Enrollment)
 	return enrollment, nil
 }
 
-func (m *MockEnrollmentRepositoryForService) FindByStudent(studentID int) ([]model.Enrollment, error) {
+func (m *MockEnrollmentRepositoryForService) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
 	var result []model.Enrollment
 	for _, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID {
+		if enrollment.StudentID == studentID && enrollment.HasStatus(statuses...) {
 			result = append(result, enrollment)
 		}
 	}
 	return result, nil
 }
 
-func (m *MockEnrollmentRepositoryForService) FindLecturesByStudent(studentID int) ([]model.Lecture, error) {
+func (m *MockEnrollmentRepositoryForService) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	var result []model.Enrollment
+	for _, enrollment := range m.enrollments {
+		if enrollment.LectureID == lectureID && enrollment.HasStatus(statuses...) {
+			result = append(result, enrollment)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockEnrollmentRepositoryForService) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
 	var result []model.Lecture
 	for _, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID {
+		if enrollment.StudentID == studentID && enrollment.IsActive() {
 			for _, lecture := range m.lectures {
-				if lecture.ID == enrollment.LectureID {
+				if lecture.ID == enrollment.LectureID && (termID == "" || lecture.TermID == termID) {
 					result = append(result, lecture)
 				}
 			}
@@ -247,40 +431,56 @@ func (m *MockEnrollmentRepositoryForService) FindLecturesByStudent(studentID int
 	return result, nil
 }
 
-func (m *MockEnrollmentRepositoryForService) CountByLectureID(lectureID int) (int, error) {
+func (m *MockEnrollmentRepositoryForService) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
 	count := 0
 	for _, enrollment := range m.enrollments {
-		if enrollment.LectureID == lectureID {
+		if enrollment.LectureID == lectureID && enrollment.IsActive() {
 			count++
 		}
 	}
 	return count, nil
 }
 
-func (m *MockEnrollmentRepositoryForService) DeleteByStudentAndLecture(studentID, lectureID int) error {
-	if m.deleteError != nil {
-		return m.deleteError
+func (m *MockEnrollmentRepositoryForService) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	var studentIDs []int
+	for _, enrollment := range m.enrollments {
+		if enrollment.LectureID == lectureID && enrollment.IsActive() {
+			studentIDs = append(studentIDs, enrollment.StudentID)
+		}
 	}
-	for i, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID && enrollment.LectureID == lectureID {
-			m.enrollments = append(m.enrollments[:i], m.enrollments[i+1:]...)
+	return studentIDs, nil
+}
+
+func (m *MockEnrollmentRepositoryForService) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	if m.updateError != nil {
+		return m.updateError
+	}
+	for i, stored := range m.enrollments {
+		if stored.ID == enrollment.ID && stored.Status == previous {
+			m.enrollments[i] = enrollment
 			return nil
 		}
 	}
-	return errors.New("enrollment not found")
+	return exception.ErrEnrollmentNotFound
 }
 
 type MockLectureRepositoryForService struct {
 	lectures      []model.Lecture
 	findByIDError error
 	updateError   error
+	// concurrentUpdates 다른 서버가 먼저 강좌를 갱신한 상황을 흉내낼 횟수
+	concurrentUpdates int
 }
 
-func (m *MockLectureRepositoryForService) FindAll() ([]model.Lecture, error) {
+func (m *MockLectureRepositoryForService) FindAll(ctx context.Context) ([]model.Lecture, error) {
 	return m.lectures, nil
 }
 
-func (m *MockLectureRepositoryForService) FindByID(id int) (model.Lecture, error) {
+func (m *MockLectureRepositoryForService) FindPage(ctx context.Context, query repository.LectureQuery) (repository.LecturePage, error) {
+	return repository.LecturePage{Lectures: m.lectures, Total: len(m.lectures)}, nil
+}
+
+func (m *MockLectureRepositoryForService) FindByID(ctx context.Context, id int) (model.Lecture, error) {
 	if m.findByIDError != nil {
 		return model.Lecture{}, m.findByIDError
 	}
@@ -289,44 +489,70 @@ func (m *MockLectureRepositoryForService) FindByID(id int) (model.Lecture, error
 			return lecture, nil
 		}
 	}
-	return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepositoryForService) FindByName(name string) (model.Lecture, error) {
+func (m *MockLectureRepositoryForService) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
 	for _, lecture := range m.lectures {
-		if lecture.Name == name {
+		if lecture.TermID == termID && lecture.Name == name {
 			return lecture, nil
 		}
 	}
-	return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepositoryForService) Create(lecture model.Lecture) (model.Lecture, error) {
+func (m *MockLectureRepositoryForService) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
 	m.lectures = append(m.lectures, lecture)
 	return lecture, nil
 }
 
-func (m *MockLectureRepositoryForService) Delete(id int) error {
+func (m *MockLectureRepositoryForService) Delete(ctx context.Context, id int) error {
 	for i, lecture := range m.lectures {
 		if lecture.ID == id {
 			m.lectures = append(m.lectures[:i], m.lectures[i+1:]...)
 			return nil
 		}
 	}
-	return errors.New(exception.ErrLectureNotFound)
+	return exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepositoryForService) UpdateCurrentEnrollment(lectureID int, currentEnrollment int) error {
+func (m *MockLectureRepositoryForService) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
 	if m.updateError != nil {
 		return m.updateError
 	}
 	for i, lecture := range m.lectures {
 		if lecture.ID == lectureID {
+			if m.concurrentUpdates > 0 {
+				m.concurrentUpdates--
+				m.lectures[i].Version++
+			}
+			if m.lectures[i].Version != expectedVersion {
+				return &repository.ConflictError{LectureID: lectureID, ExpectedVersion: expectedVersion}
+			}
 			m.lectures[i].CurrentEnrollment = currentEnrollment
+			m.lectures[i].Version++
 			return nil
 		}
 	}
-	return errors.New(exception.ErrLectureNotFound)
+	return exception.ErrLectureNotFound
+}
+
+func (m *MockLectureRepositoryForService) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	if m.updateError != nil {
+		return model.Lecture{}, m.updateError
+	}
+	for i, existing := range m.lectures {
+		if existing.ID == lecture.ID {
+			if existing.Version != expectedVersion {
+				return model.Lecture{}, &repository.ConflictError{LectureID: lecture.ID, ExpectedVersion: expectedVersion}
+			}
+			lecture.CurrentEnrollment = existing.CurrentEnrollment
+			lecture.Version = existing.Version + 1
+			m.lectures[i] = lecture
+			return lecture, nil
+		}
+	}
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
 type MockStudentRepositoryForService struct {
@@ -334,12 +560,12 @@ type MockStudentRepositoryForService struct {
 	findByIDError error
 }
 
-func (m *MockStudentRepositoryForService) Create(student model.Student) (model.Student, error) {
+func (m *MockStudentRepositoryForService) Create(ctx context.Context, student model.Student) (model.Student, error) {
 	m.students = append(m.students, student)
 	return student, nil
 }
 
-func (m *MockStudentRepositoryForService) FindByID(id int) (model.Student, error) {
+func (m *MockStudentRepositoryForService) FindByID(ctx context.Context, id int) (model.Student, error) {
 	if m.findByIDError != nil {
 		return model.Student{}, m.findByIDError
 	}
@@ -348,5 +574,5 @@ func (m *MockStudentRepositoryForService) FindByID(id int) (model.Student, error
 			return student, nil
 		}
 	}
-	return model.Student{}, errors.New(exception.ErrStudentNotFound)
+	return model.Student{}, exception.ErrStudentNotFound
 }
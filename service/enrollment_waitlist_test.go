@@ -0,0 +1,208 @@
+package service
+
+import (
+	"errors"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestEnrollmentWaitlist(t *testing.T) {
+	// newWaitlistServiceWithLocks 정원 1명인 강좌(2001)에 학생 1001이 수강 중이고, 학생 1002 ~ 1004가 있는 메모리 저장소
+	newWaitlistServiceWithLocks := func(t *testing.T, locks lock.LockManager) (EnrollmentService, *repository.MemoryStore) {
+		t.Helper()
+		store := repository.NewMemoryStore()
+		full, _ := model.NewLecture(2001, "데이터베이스", 1, 3, model.Monday, "09:00", "10:30")
+		_, _ = repository.NewMemoryLectureRepository(store).Create(t.Context(), *full)
+		for _, id := range []int{1001, 1002, 1003, 1004} {
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: id})
+		}
+
+		service := NewEnrollmentServiceWithUnitOfWork(
+			repository.NewMemoryUnitOfWork(store),
+			repository.NewMemoryEnrollmentRepository(store),
+			locks,
+			constants.LockTimeoutDefault,
+		)
+		_, _ = service.Enroll(t.Context(), 1001, 2001)
+		return service, store
+	}
+
+	newWaitlistService := func(t *testing.T) (EnrollmentService, *repository.MemoryStore) {
+		t.Helper()
+		return newWaitlistServiceWithLocks(t, lock.NewMemoryLockManager())
+	}
+
+	enrolledIn := func(t *testing.T, store *repository.MemoryStore, lectureID int) []int {
+		studentIDs, _ := repository.NewMemoryEnrollmentRepository(store).FindStudentIDsByLecture(t.Context(), lectureID)
+		return studentIDs
+	}
+
+	t.Run("성공 : 등록 순서대로 대기 순번 부여", func(t *testing.T) {
+		// given
+		service, _ := newWaitlistService(t)
+
+		// when
+		first, _ := service.JoinWaitlist(t.Context(), 1002, 2001)
+		second, _ := service.JoinWaitlist(t.Context(), 1003, 2001)
+
+		// then
+		if first.Position != 1 || second.Position != 2 || second.Waiting != 2 {
+			t.Errorf("기대 : (1, 2, 대기 2명), 결과 : (%d, %d, 대기 %d명)", first.Position, second.Position, second.Waiting)
+		}
+	})
+
+	t.Run("예외 : 정원이 남은 강좌", func(t *testing.T) {
+		// given
+		service, _ := newWaitlistService(t)
+		_ = service.Cancel(t.Context(), 1001, 2001)
+
+		// when
+		_, err := service.JoinWaitlist(t.Context(), 1002, 2001)
+
+		// then
+		if !errors.Is(err, exception.ErrWaitlistLectureNotFull) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrWaitlistLectureNotFull, err)
+		}
+	})
+
+	t.Run("예외 : 이미 수강 중인 강좌", func(t *testing.T) {
+		// given
+		service, _ := newWaitlistService(t)
+
+		// when
+		_, err := service.JoinWaitlist(t.Context(), 1001, 2001)
+
+		// then
+		if !errors.Is(err, exception.ErrWaitlistAlreadyEnrolled) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrWaitlistAlreadyEnrolled, err)
+		}
+	})
+
+	t.Run("취소 시 대기 1순위 승격", func(t *testing.T) {
+		// given
+		service, store := newWaitlistService(t)
+		_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+		_, _ = service.JoinWaitlist(t.Context(), 1003, 2001)
+
+		// when
+		err := service.Cancel(t.Context(), 1001, 2001)
+
+		// then
+		lecture, _ := repository.NewMemoryLectureRepository(store).FindByID(t.Context(), 2001)
+		next, _ := service.WaitlistPosition(t.Context(), 1003, 2001)
+		if err != nil || !slices.Equal(enrolledIn(t, store, 2001), []int{1002}) || lecture.CurrentEnrollment != 1 || next.Position != 1 {
+			t.Errorf("기대 : 1002 승격, 수강 인원 1, 1003 대기 1순위, 결과 : %v, %d, %d (%v)",
+				enrolledIn(t, store, 2001), lecture.CurrentEnrollment, next.Position, err)
+		}
+	})
+
+	t.Run("대기 1순위 학생이 다른 요청 중이면 뒤 순번이나 대기하지 않은 학생이 앞지르지 않음", func(t *testing.T) {
+		// given
+		locks := lock.NewMemoryLockManager()
+		service, store := newWaitlistServiceWithLocks(t, locks)
+		_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+		_, _ = service.JoinWaitlist(t.Context(), 1003, 2001)
+		busy, _ := locks.Acquire(t.Context(), lock.StudentKey(1002), time.Second)
+
+		// when
+		done := make(chan error)
+		go func() {
+			done <- service.Cancel(t.Context(), 1001, 2001)
+		}()
+		time.Sleep(20 * time.Millisecond)
+		enrolledWhileBusy := enrolledIn(t, store, 2001)
+		_, errDirect := service.Enroll(t.Context(), 1004, 2001)
+		busy()
+		err := <-done
+
+		// then
+		next, _ := service.WaitlistPosition(t.Context(), 1003, 2001)
+		if len(enrolledWhileBusy) != 0 || !errors.Is(errDirect, exception.ErrLectureCapacityExceeded) {
+			t.Errorf("기대 : 1002의 요청이 끝날 때까지 승격 없음, 1004 %s, 결과 : %v, %v", exception.ErrLectureCapacityExceeded, enrolledWhileBusy, errDirect)
+		}
+		if err != nil || !slices.Equal(enrolledIn(t, store, 2001), []int{1002}) || next.Position != 1 {
+			t.Errorf("기대 : 1002 승격, 1003 대기 1순위, 결과 : %v, %d (%v)", enrolledIn(t, store, 2001), next.Position, err)
+		}
+	})
+
+	t.Run("시간이 겹치는 학생은 건너뛰고 다음 학생 승격", func(t *testing.T) {
+		// given
+		service, store := newWaitlistService(t)
+		overlapping, _ := model.NewLecture(2002, "운영체제", 30, 3, model.Monday, "10:00", "11:30")
+		_, _ = repository.NewMemoryLectureRepository(store).Create(t.Context(), *overlapping)
+		_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+		_, _ = service.JoinWaitlist(t.Context(), 1003, 2001)
+		_, _ = service.Enroll(t.Context(), 1002, 2002)
+
+		// when
+		_ = service.Cancel(t.Context(), 1001, 2001)
+
+		// then
+		_, skippedErr := service.WaitlistPosition(t.Context(), 1002, 2001)
+		withdrawn, _ := service.History(t.Context(), 1002, "", model.EnrollmentStatusWithdrawn)
+		if !slices.Equal(enrolledIn(t, store, 2001), []int{1003}) || !errors.Is(skippedErr, exception.ErrWaitlistNotFound) || len(withdrawn) != 1 {
+			t.Errorf("기대 : 1003 승격, 1002 대기 제외 (WITHDRAWN), 결과 : %v (%v, %v)", enrolledIn(t, store, 2001), skippedErr, withdrawn)
+		}
+	})
+
+	t.Run("정원을 늘리면 대기 순서대로 승격, 대기하지 않은 학생은 남은 자리가 없음", func(t *testing.T) {
+		// given
+		service, store := newWaitlistService(t)
+		_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+		_, _ = service.JoinWaitlist(t.Context(), 1003, 2001)
+		lectureService := NewLectureServiceWithWaitlist(
+			repository.NewMemoryLectureRepository(store), repository.NewMemoryEnrollmentRepository(store),
+			nil, nil, service, model.DefaultSchedulePolicy(), "")
+		capacity := 2
+
+		// when
+		updated, err := lectureService.Update(t.Context(), 2001, dto.UpdateLectureRequest{Capacity: &capacity})
+		_, errDirect := service.Enroll(t.Context(), 1004, 2001)
+
+		// then
+		next, _ := service.WaitlistPosition(t.Context(), 1003, 2001)
+		if err != nil || updated.CurrentEnrollment != 2 || !slices.Equal(enrolledIn(t, store, 2001), []int{1001, 1002}) ||
+			next.Position != 1 || !errors.Is(errDirect, exception.ErrLectureCapacityExceeded) {
+			t.Errorf("기대 : 1002 승격 (수강 인원 2), 1003 대기 1순위, 1004 %s, 결과 : %v, %d, %d, %v (%v)",
+				exception.ErrLectureCapacityExceeded, enrolledIn(t, store, 2001), updated.CurrentEnrollment, next.Position, errDirect, err)
+		}
+	})
+
+	t.Run("대기 취소", func(t *testing.T) {
+		// given
+		service, _ := newWaitlistService(t)
+		_, _ = service.JoinWaitlist(t.Context(), 1002, 2001)
+
+		// when
+		_ = service.LeaveWaitlist(t.Context(), 1002, 2001)
+
+		// then
+		_, err := service.WaitlistPosition(t.Context(), 1002, 2001)
+		if !errors.Is(err, exception.ErrWaitlistNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrWaitlistNotFound, err)
+		}
+	})
+
+	t.Run("취소 후 다시 신청하면 이전 수강신청은 이력으로 유지", func(t *testing.T) {
+		// given
+		service, _ := newWaitlistService(t)
+		_ = service.Cancel(t.Context(), 1001, 2001)
+
+		// when
+		_, err := service.Enroll(t.Context(), 1001, 2001)
+
+		// then
+		history, _ := service.History(t.Context(), 1001, "")
+		if err != nil || len(history) != 2 ||
+			history[0].Status != model.EnrollmentStatusEnrolled || history[1].Status != model.EnrollmentStatusDropped {
+			t.Errorf("기대 : [ENROLLED, DROPPED], 결과 : %+v (%v)", history, err)
+		}
+	})
+}
@@ -1,97 +1,634 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
+	"golang-course-registration/common/i18n"
 	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/infrastructure/search"
 	"golang-course-registration/model"
 	"golang-course-registration/repository"
+	"log"
+	"strings"
+	"time"
 )
 
 type LectureService interface {
-	Create(req dto.CreateLectureRequest) (dto.LectureResponse, error)
-	FindByID(id int) (dto.LectureResponse, error)
-	List() ([]dto.LectureResponse, error)
-	Delete(id int) error
+	Create(ctx context.Context, req dto.CreateLectureRequest) (dto.LectureResponse, error)
+	FindByID(ctx context.Context, id int) (dto.LectureResponse, error)
+	// List termID가 비어 있으면 모든 학기
+	List(ctx context.Context, termID string) ([]dto.LectureResponse, error)
+	ListPage(ctx context.Context, req dto.LectureListRequest) (dto.LecturePageResponse, error)
+	Search(ctx context.Context, query, termID string) ([]dto.LectureSearchResponse, error)
+	Update(ctx context.Context, id int, req dto.UpdateLectureRequest) (dto.LectureResponse, error)
+	Delete(ctx context.Context, id int) error
+	// CloneTerm 원본 학기의 강좌를 대상 학기로 복사 (dry_run이면 계획만 반환)
+	CloneTerm(ctx context.Context, req dto.CloneTermRequest) (dto.CloneTermResponse, error)
 }
 
 type lectureService struct {
 	lectureRepo    repository.LectureRepository
 	enrollmentRepo repository.EnrollmentRepository
+	termRepo       repository.TermRepository
+	roomRepo       repository.RoomRepository
+	waitlist       WaitlistPromoter
+	locks          lock.LockManager
+	lockTimeout    time.Duration
+	index          *search.LectureIndex
+	schedule       model.SchedulePolicy
+	activeTerm     string
 }
 
 func NewLectureService(lectureRepo repository.LectureRepository) LectureService {
-	return &lectureService{lectureRepo: lectureRepo}
+	return &lectureService{
+		lectureRepo: lectureRepo,
+		index:       search.NewLectureIndex(),
+		schedule:    model.DefaultSchedulePolicy(),
+	}
 }
 
 func NewLectureServiceWithEnrollment(lectureRepo repository.LectureRepository, enrollmentRepo repository.EnrollmentRepository) LectureService {
+	return NewLectureServiceWithSchedule(lectureRepo, enrollmentRepo, model.DefaultSchedulePolicy())
+}
+
+// NewLectureServiceWithSchedule 강좌 등록/변경 시 수업 시간의 분 단위와 캠퍼스 운영 시간을 schedule로 검사
+func NewLectureServiceWithSchedule(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	schedule model.SchedulePolicy,
+) LectureService {
+	return NewLectureServiceWithTerms(lectureRepo, enrollmentRepo, nil, schedule, "")
+}
+
+// NewLectureServiceWithTerms 학기를 생략한 강좌 등록과 목록/검색 조회는 activeTerm 학기로 처리
+// termRepo가 있으면 등록할 강좌의 학기가 존재하는지 확인
+func NewLectureServiceWithTerms(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	termRepo repository.TermRepository,
+	schedule model.SchedulePolicy,
+	activeTerm string,
+) LectureService {
+	return NewLectureServiceWithRooms(lectureRepo, enrollmentRepo, termRepo, nil, schedule, activeTerm)
+}
+
+// WaitlistPromoter 정원이 늘어난 강좌의 빈자리를 대기 순서대로 승격 (EnrollmentService가 구현)
+type WaitlistPromoter interface {
+	PromoteWaitlist(ctx context.Context, lectureID int) error
+}
+
+// NewLectureServiceWithRooms roomRepo가 있으면 강좌 등록/변경 시 강의실 좌석 수와 같은 강의실의 시간 중복을 검사
+func NewLectureServiceWithRooms(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	termRepo repository.TermRepository,
+	roomRepo repository.RoomRepository,
+	schedule model.SchedulePolicy,
+	activeTerm string,
+) LectureService {
+	return NewLectureServiceWithWaitlist(lectureRepo, enrollmentRepo, termRepo, roomRepo, nil, schedule, activeTerm)
+}
+
+// NewLectureServiceWithWaitlist waitlist가 있으면 정원을 늘린 뒤 생긴 빈자리를 대기 순서대로 승격
+// (대기 중인 학생보다 대기하지 않은 학생이 먼저 신청하지 않도록)
+func NewLectureServiceWithWaitlist(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	termRepo repository.TermRepository,
+	roomRepo repository.RoomRepository,
+	waitlist WaitlistPromoter,
+	schedule model.SchedulePolicy,
+	activeTerm string,
+) LectureService {
+	return NewLectureServiceWithLocks(
+		lectureRepo, enrollmentRepo, termRepo, roomRepo, waitlist,
+		lock.NewMemoryLockManager(), constants.LockTimeoutDefault, schedule, activeTerm)
+}
+
+// NewLectureServiceWithLocks 강의실을 배정하는 등록/변경은 강의실별 잠금을 locks에서 lockTimeout 안에 획득하여
+// 같은 강의실의 시간 중복 검사와 저장 사이에 다른 배정이 끼어들지 않게 함
+func NewLectureServiceWithLocks(
+	lectureRepo repository.LectureRepository,
+	enrollmentRepo repository.EnrollmentRepository,
+	termRepo repository.TermRepository,
+	roomRepo repository.RoomRepository,
+	waitlist WaitlistPromoter,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+	schedule model.SchedulePolicy,
+	activeTerm string,
+) LectureService {
 	return &lectureService{
 		lectureRepo:    lectureRepo,
 		enrollmentRepo: enrollmentRepo,
+		termRepo:       termRepo,
+		roomRepo:       roomRepo,
+		waitlist:       waitlist,
+		locks:          locks,
+		lockTimeout:    lockTimeout,
+		index:          search.NewLectureIndex(),
+		schedule:       schedule,
+		activeTerm:     activeTerm,
+	}
+}
+
+// Create 강의실을 배정하면 강의실 잠금을 쥔 채 검사하고 저장
+func (s *lectureService) Create(ctx context.Context, req dto.CreateLectureRequest) (dto.LectureResponse, error) {
+	release, err := s.lockRoom(ctx, req.RoomID)
+	if err != nil {
+		return dto.LectureResponse{}, err
+	}
+	defer release()
+
+	lecture, err := s.newLecture(ctx, req)
+	if err != nil {
+		return dto.LectureResponse{}, err
+	}
+
+	createdLecture, err := s.lectureRepo.Create(ctx, *lecture)
+	if err != nil {
+		return dto.LectureResponse{}, err
 	}
+	s.index.Add(createdLecture)
+
+	return dto.NewLectureResponse(createdLecture, i18n.FromContext(ctx)), nil
 }
 
-func (s *lectureService) Create(req dto.CreateLectureRequest) (dto.LectureResponse, error) {
-	lecture, err := model.NewLecture(
+// newLecture 등록 요청을 검증하여 저장할 강좌로 변환 (저장하지 않으므로 학기 복사 계획의 검사에도 사용)
+func (s *lectureService) newLecture(ctx context.Context, req dto.CreateLectureRequest) (*model.Lecture, error) {
+	slots := dto.ToMeetingSlotInputs(req.Slots)
+	lecture, err := model.NewLectureWithSlots(
 		req.ID,
 		req.Name,
 		req.Capacity,
 		req.Credit,
-		req.Day,
-		req.StartTime,
-		req.EndTime,
+		slots,
 	)
 
 	if err != nil {
-		return dto.LectureResponse{}, err
+		return nil, err
 	}
 
-	_, errExistName := s.lectureRepo.FindByName(lecture.Name)
+	if err := s.schedule.Check(slots); err != nil {
+		return nil, err
+	}
+
+	lecture.TermID, err = s.lectureTerm(ctx, req.TermID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, errExistName := s.lectureRepo.FindByName(ctx, lecture.TermID, lecture.Name)
 	if errExistName == nil {
-		return dto.LectureResponse{}, errors.New(exception.ErrLectureNameDuplicate)
+		return nil, exception.ErrLectureNameDuplicate
 	}
 
-	_, errExistID := s.lectureRepo.FindByID(lecture.ID)
+	_, errExistID := s.lectureRepo.FindByID(ctx, lecture.ID)
 	if errExistID == nil {
-		return dto.LectureResponse{}, errors.New(exception.ErrLectureIDDuplicate)
+		return nil, exception.ErrLectureIDDuplicate
+	}
+
+	lecture.RoomID = req.RoomID
+	if err := s.checkRoom(ctx, *lecture); err != nil {
+		return nil, err
 	}
 
-	createdLecture, err := s.lectureRepo.Create(*lecture)
+	return lecture, nil
+}
+
+// lockRoom 강의실별 잠금 획득, 강의실을 배정하지 않거나 강의실 검사를 하지 않으면 잠그지 않음
+func (s *lectureService) lockRoom(ctx context.Context, roomID string) (lock.Release, error) {
+	if roomID == "" || s.roomRepo == nil || s.locks == nil {
+		return func() {}, nil
+	}
+	return s.locks.Acquire(ctx, lock.RoomKey(roomID), s.lockTimeout)
+}
+
+// checkRoom 배정할 강의실이 있는지, 정원이 좌석 수 이하인지, 같은 학기에 같은 강의실을 쓰는 다른 강좌와 수업 시간이 겹치지 않는지 검사
+func (s *lectureService) checkRoom(ctx context.Context, lecture model.Lecture) error {
+	if lecture.RoomID == "" || s.roomRepo == nil {
+		return nil
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, lecture.RoomID)
 	if err != nil {
-		return dto.LectureResponse{}, err
+		return notFoundError(err, exception.ErrRoomNotFound)
+	}
+	if lecture.Capacity > room.Seats {
+		return exception.ErrLectureCapacityExceedsSeats
 	}
 
-	return dto.NewLectureResponse(createdLecture), nil
+	page, err := s.lectureRepo.FindPage(ctx, repository.LectureQuery{TermID: lecture.TermID, RoomID: lecture.RoomID})
+	if err != nil {
+		return err
+	}
+	for _, other := range page.Lectures {
+		if other.ID != lecture.ID && lecture.HasTimeConflict(&other) {
+			return exception.RoomDoubleBooked(other.Name)
+		}
+	}
+	return nil
 }
 
-func (s *lectureService) FindByID(id int) (dto.LectureResponse, error) {
-	lecture, err := s.lectureRepo.FindByID(id)
+// CloneTerm 원본 학기의 강좌를 대상 학기로 복사, 폐강 예정 강좌는 제외
+// 강좌마다 등록(Create)과 같은 규칙으로 검사하며, dry_run이면 저장하지 않고 계획만 반환
+// 검증에 실패한 강좌는 결과에 이유를 남기고 건너뛰며, 저장소 오류가 나면 중단 (이미 등록한 강좌는 유지)
+func (s *lectureService) CloneTerm(ctx context.Context, req dto.CloneTermRequest) (dto.CloneTermResponse, error) {
+	if err := req.Validate(); err != nil {
+		return dto.CloneTermResponse{}, err
+	}
+	if s.termRepo != nil {
+		if _, err := s.termRepo.FindByID(ctx, req.SourceTerm); err != nil {
+			return dto.CloneTermResponse{}, notFoundError(err, exception.ErrTermNotFound)
+		}
+	}
+	if _, err := s.lectureTerm(ctx, req.TargetTerm); err != nil {
+		return dto.CloneTermResponse{}, err
+	}
+
+	page, err := s.lectureRepo.FindPage(ctx, repository.LectureQuery{TermID: req.SourceTerm})
+	if err != nil {
+		return dto.CloneTermResponse{}, err
+	}
+
+	locale := i18n.FromContext(ctx)
+	response := dto.CloneTermResponse{
+		SourceTerm: req.SourceTerm,
+		TargetTerm: req.TargetTerm,
+		DryRun:     req.DryRun,
+		Lectures:   make([]dto.CloneLectureResponse, 0, len(page.Lectures)),
+	}
+	// planned dry_run에서 앞서 등록 예정으로 계획한 강좌번호 (id_map으로 같은 번호가 겹치는 경우)
+	planned := make(map[int]bool)
+	for _, lecture := range page.Lectures {
+		item := dto.CloneLectureResponse{SourceID: lecture.ID, Name: lecture.Name, SourceCapacity: lecture.Capacity}
+		if lecture.Discontinued {
+			item.Action = dto.CloneActionSkip
+			response.Add(item)
+			continue
+		}
+
+		createReq := req.LectureRequest(lecture)
+		item.TargetID = createReq.ID
+		item.TargetCapacity = createReq.Capacity
+
+		if req.DryRun {
+			_, err = s.newLecture(ctx, createReq)
+			if err == nil && planned[createReq.ID] {
+				err = exception.ErrLectureIDDuplicate
+			}
+			planned[createReq.ID] = true
+		} else {
+			_, err = s.Create(ctx, createReq)
+		}
+
+		item.Action = dto.CloneActionCreate
+		if err != nil {
+			cloneErr, ok := dto.NewCloneErrorResponse(err, locale)
+			if !ok {
+				return dto.CloneTermResponse{}, err
+			}
+			item.Action, item.Error = dto.CloneActionError, cloneErr
+		}
+		response.Add(item)
+	}
+
+	return response, nil
+}
+
+// lectureTerm 등록할 강좌의 학기, 생략하면 현재 학기
+func (s *lectureService) lectureTerm(ctx context.Context, termID string) (string, error) {
+	termID, err := resolveTerm(termID, s.activeTerm)
+	if err != nil || s.termRepo == nil {
+		return termID, err
+	}
+	if _, err := s.termRepo.FindByID(ctx, termID); err != nil {
+		return "", notFoundError(err, exception.ErrTermNotFound)
+	}
+	return termID, nil
+}
+
+func (s *lectureService) FindByID(ctx context.Context, id int) (dto.LectureResponse, error) {
+	lecture, err := s.lectureRepo.FindByID(ctx, id)
 	if err != nil {
 		return dto.LectureResponse{}, err
 	}
-	return dto.NewLectureResponse(lecture), nil
+	return dto.NewLectureResponse(lecture, i18n.FromContext(ctx)), nil
 }
 
-func (s *lectureService) List() ([]dto.LectureResponse, error) {
-	lectures, err := s.lectureRepo.FindAll()
+func (s *lectureService) List(ctx context.Context, termID string) ([]dto.LectureResponse, error) {
+	if termID != "" {
+		if err := model.ValidateTermID(termID); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := s.lectureRepo.FindPage(ctx, repository.LectureQuery{TermID: termID})
 	if err != nil {
 		return nil, err
 	}
 
-	responses := make([]dto.LectureResponse, 0, len(lectures))
-	for _, lecture := range lectures {
-		response := dto.NewLectureResponse(lecture)
+	responses := make([]dto.LectureResponse, 0, len(page.Lectures))
+	for _, lecture := range page.Lectures {
+		response := dto.NewLectureResponse(lecture, i18n.FromContext(ctx))
 		responses = append(responses, response)
 	}
 
 	return responses, nil
 }
 
-func (s *lectureService) Delete(id int) error {
-	_, err := s.lectureRepo.FindByID(id)
+// ListPage 조건에 맞는 강좌 목록을 정렬하여 페이지 단위로 조회
+func (s *lectureService) ListPage(ctx context.Context, req dto.LectureListRequest) (dto.LecturePageResponse, error) {
+	query, err := newLectureQuery(req, s.activeTerm)
+	if err != nil {
+		return dto.LecturePageResponse{}, err
+	}
+
+	page, err := s.lectureRepo.FindPage(ctx, query)
+	if err != nil {
+		return dto.LecturePageResponse{}, err
+	}
+
+	responses := make([]dto.LectureResponse, 0, len(page.Lectures))
+	for _, lecture := range page.Lectures {
+		responses = append(responses, dto.NewLectureResponse(lecture, i18n.FromContext(ctx)))
+	}
+
+	return dto.LecturePageResponse{
+		Lectures:   responses,
+		Page:       query.Offset/query.Limit + 1,
+		Size:       query.Limit,
+		TotalCount: page.Total,
+		TotalPages: (page.Total + query.Limit - 1) / query.Limit,
+	}, nil
+}
+
+// newLectureQuery 요청 값을 검증하여 저장소 조회 조건으로 변환 (요일은 월요일/Monday/1 등도 허용)
+func newLectureQuery(req dto.LectureListRequest, activeTerm string) (repository.LectureQuery, error) {
+	termID, err := resolveTerm(req.Term, activeTerm)
+	if err != nil {
+		return repository.LectureQuery{}, err
+	}
+
+	var day model.Day
+	if req.Day != "" {
+		parsed, err := model.ParseDay(string(req.Day))
+		if err != nil {
+			return repository.LectureQuery{}, err
+		}
+		day = parsed
+	}
+
+	if req.Credit != 0 && (req.Credit < constants.LectureCreditMin || req.Credit > constants.LectureCreditMax) {
+		return repository.LectureQuery{}, exception.ErrLectureCreditInvalid
+	}
+
+	startFrom, err := parseOptionalTime(req.StartFrom)
+	if err != nil {
+		return repository.LectureQuery{}, err
+	}
+	endUntil, err := parseOptionalTime(req.EndUntil)
+	if err != nil {
+		return repository.LectureQuery{}, err
+	}
+
+	if req.Sort != "" && !repository.IsValidLectureSort(req.Sort) {
+		return repository.LectureQuery{}, exception.ErrLectureSortInvalid
+	}
+
+	if req.Order != "" && req.Order != "asc" && req.Order != "desc" {
+		return repository.LectureQuery{}, exception.ErrSortOrderInvalid
+	}
+
+	page := req.Page
+	if page == 0 {
+		page = 1
+	}
+	if page < 1 {
+		return repository.LectureQuery{}, exception.ErrPageInvalid
+	}
+
+	size := req.Size
+	if size == 0 {
+		size = constants.LecturePageSizeDefault
+	}
+	if size < 1 || size > constants.LecturePageSizeMax {
+		return repository.LectureQuery{}, exception.ErrPageSizeInvalid
+	}
+
+	return repository.LectureQuery{
+		TermID:     termID,
+		Day:        day,
+		Credit:     req.Credit,
+		OpenOnly:   req.OpenOnly,
+		StartFrom:  startFrom,
+		EndUntil:   endUntil,
+		Name:       strings.TrimSpace(req.Name),
+		Sort:       req.Sort,
+		Descending: req.Order == "desc",
+		Offset:     (page - 1) * size,
+		Limit:      size,
+	}, nil
+}
+
+// parseOptionalTime 비어 있으면 조건 없음(0), 있으면 "HH:MM" 형식 검사
+func parseOptionalTime(value string) (model.TimeOfDay, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return model.ParseTimeOfDay(value)
+}
+
+// Update 보낸 항목만 변경, 변경된 강좌 전체를 다시 검증하고 정원은 현재 수강 인원 이상이어야 함
+// 수업 시간이 바뀌면 수강생의 다른 강좌와 겹치는지 검사하여, 겹치는 수강생이 있으면 변경하지 않고 목록과 함께 거부
+// 강의실, 정원, 수업 시간 중 하나라도 바뀌면 강의실 잠금을 쥔 채 강의실 좌석 수와 같은 강의실의 시간 중복을 다시 검사
+// 검사 도중 수강신청/취소로 강좌 버전이 바뀌면 처음부터 다시 검사, 정원이 늘었으면 변경 후 대기 학생을 승격
+func (s *lectureService) Update(ctx context.Context, id int, req dto.UpdateLectureRequest) (dto.LectureResponse, error) {
+	var previous, updated model.Lecture
+	err := retryOnConflict(func() error {
+		current, err := s.lectureRepo.FindByID(ctx, id)
+		if err != nil {
+			return notFoundError(err, exception.ErrLectureNotFound)
+		}
+
+		name, capacity, credit, slots := req.Apply(current)
+		revised, err := current.Revise(name, capacity, credit, slots)
+		if err != nil {
+			return err
+		}
+		revised.Discontinued = req.ApplyDiscontinued(current)
+		revised.RoomID = req.ApplyRoom(current)
+
+		if revised.Name != current.Name {
+			if _, err := s.lectureRepo.FindByName(ctx, current.TermID, revised.Name); err == nil {
+				return exception.ErrLectureNameDuplicate
+			}
+		}
+
+		// 수업 시간이 바뀐 경우에만 정책을 검사 (정책이 바뀌기 전에 등록된 강좌도 다른 항목은 변경 가능)
+		if !revised.HasSameSchedule(&current) {
+			if err := s.schedule.Check(slots); err != nil {
+				return err
+			}
+			students, err := s.lockEnrolledStudents(ctx, revised.ID)
+			if err != nil {
+				return err
+			}
+			defer students.release()
+
+			if err := s.checkStudentTimeConflicts(ctx, revised, students); err != nil {
+				return err
+			}
+		}
+		if revised.RoomID != current.RoomID || revised.Capacity != current.Capacity || !revised.HasSameSchedule(&current) {
+			release, err := s.lockRoom(ctx, revised.RoomID)
+			if err != nil {
+				return err
+			}
+			defer release()
+
+			if err := s.checkRoom(ctx, revised); err != nil {
+				return err
+			}
+		}
+
+		previous = current
+		updated, err = s.lectureRepo.Update(ctx, revised, current.Version)
+		return err
+	})
 	if err != nil {
-		return errors.New(exception.ErrLectureNotFound)
+		return dto.LectureResponse{}, err
 	}
 
-	return s.lectureRepo.Delete(id)
+	if updated.Name != previous.Name {
+		s.index.Add(updated)
+	}
+	if updated.Capacity > previous.Capacity {
+		updated = s.promoteWaitlist(ctx, updated)
+	}
+	return dto.NewLectureResponse(updated, i18n.FromContext(ctx)), nil
+}
+
+// promoteWaitlist 늘어난 정원만큼 대기 학생을 승격하고 승격이 반영된 강좌를 반환
+// 변경은 이미 저장되었으므로 승격에 실패해도 변경은 성공으로 응답하고, 남은 대기는 다음 취소 때 승격
+func (s *lectureService) promoteWaitlist(ctx context.Context, lecture model.Lecture) model.Lecture {
+	if s.waitlist == nil {
+		return lecture
+	}
+	if err := s.waitlist.PromoteWaitlist(ctx, lecture.ID); err != nil {
+		log.Printf("대기 승격 실패 : 강좌 %d (%v)", lecture.ID, err)
+		return lecture
+	}
+
+	promoted, err := s.lectureRepo.FindByID(ctx, lecture.ID)
+	if err != nil {
+		return lecture
+	}
+	return promoted
+}
+
+// lockEnrolledStudents 강좌를 수강 중인 학생마다 학생 잠금을 학번 순으로 획득
+// 수강생이 다른 강좌를 신청하면서 변경 전 수업 시간으로 검사한 채 저장하지 않도록 시간 충돌 검사와 변경 저장을 묶음
+// 잠근 뒤에 이 강좌를 새로 신청한 학생은 강좌 버전이 바뀌어 변경이 처음부터 다시 검사됨
+func (s *lectureService) lockEnrolledStudents(ctx context.Context, lectureID int) (studentLocks, error) {
+	students := studentLocks{}
+	if s.enrollmentRepo == nil {
+		return students, nil
+	}
+
+	studentIDs, err := s.enrollmentRepo.FindStudentIDsByLecture(ctx, lectureID)
+	if err != nil {
+		return nil, err
+	}
+	for _, studentID := range studentIDs {
+		release, err := s.locks.Acquire(ctx, lock.StudentKey(studentID), s.lockTimeout)
+		if err != nil {
+			students.release()
+			return nil, err
+		}
+		students[studentID] = release
+	}
+	return students, nil
+}
+
+// checkStudentTimeConflicts 잠근 수강생마다 같은 학기의 다른 수강 강좌와 변경된 시간이 겹치는지 검사
+func (s *lectureService) checkStudentTimeConflicts(ctx context.Context, lecture model.Lecture, students studentLocks) error {
+	var conflicts []exception.StudentConflict
+	for _, studentID := range students.ids() {
+		enrolled, err := s.enrollmentRepo.FindLecturesByStudent(ctx, studentID, lecture.TermID)
+		if err != nil {
+			return err
+		}
+		for _, other := range enrolled {
+			if other.ID != lecture.ID && lecture.HasTimeConflict(&other) {
+				conflicts = append(conflicts, exception.StudentConflict{
+					StudentID:   studentID,
+					LectureID:   other.ID,
+					LectureName: other.Name,
+				})
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return exception.StudentTimeConflicts(conflicts)
+	}
+	return nil
+}
+
+func (s *lectureService) Delete(ctx context.Context, id int) error {
+	_, err := s.lectureRepo.FindByID(ctx, id)
+	if err != nil {
+		return notFoundError(err, exception.ErrLectureNotFound)
+	}
+
+	if err := s.lectureRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.index.Remove(id)
+	return nil
+}
+
+// Search 강좌명 검색 (초성, 공백 무시, 오타 허용), 일치도가 높은 순, 학기를 생략하면 현재 학기
+// 색인은 첫 검색 시 전체 강좌로 만들고 이후 강좌 등록/삭제 시 갱신
+func (s *lectureService) Search(ctx context.Context, query, termID string) ([]dto.LectureSearchResponse, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, exception.ErrSearchQueryRequired
+	}
+
+	termID, err := resolveTerm(termID, s.activeTerm)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.index.Ready() {
+		lectures, err := s.lectureRepo.FindAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.index.Rebuild(lectures)
+	}
+
+	results := s.index.Search(query, termID, constants.LectureSearchLimit)
+	responses := make([]dto.LectureSearchResponse, 0, len(results))
+	for _, result := range results {
+		// 수강 인원은 색인에 두지 않고 저장소에서 최신 값을 조회
+		lecture, err := s.lectureRepo.FindByID(ctx, result.LectureID)
+		if err != nil {
+			if errors.Is(err, exception.ErrLectureNotFound) {
+				s.index.Remove(result.LectureID)
+				continue
+			}
+			return nil, err
+		}
+		responses = append(responses, dto.LectureSearchResponse{
+			LectureResponse: dto.NewLectureResponse(lecture, i18n.FromContext(ctx)),
+			Score:           result.Score,
+		})
+	}
+
+	return responses, nil
 }
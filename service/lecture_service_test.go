@@ -1,10 +1,15 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"golang-course-registration/common/constants"
 	"golang-course-registration/common/exception"
 	"golang-course-registration/controller/dto"
 	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -16,11 +21,11 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 			req := dto.CreateLectureRequest{
 				ID: 1001, Name: "데이터베이스", Capacity: 30, Credit: 3,
-				Day: model.Monday, StartTime: "09:00", EndTime: "10:30",
+				Slots: []dto.MeetingSlotRequest{{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"}},
 			}
 
 			// when
-			response, _ := service.Create(req)
+			response, _ := service.Create(t.Context(), req)
 
 			// then
 			if response.ID != 1001 || response.Name != "데이터베이스" {
@@ -35,14 +40,14 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 			req := dto.CreateLectureRequest{
 				ID: 1001, Name: "데이터베이스", Capacity: 30, Credit: 3,
-				Day: model.Monday, StartTime: "09:00", EndTime: "10:30",
+				Slots: []dto.MeetingSlotRequest{{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"}},
 			}
 
 			// when
-			_, err := service.Create(req)
+			_, err := service.Create(t.Context(), req)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureNameDuplicate {
+			if !errors.Is(err, exception.ErrLectureNameDuplicate) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNameDuplicate, err)
 			}
 		})
@@ -54,14 +59,14 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 			req := dto.CreateLectureRequest{
 				ID: 1001, Name: "데이터베이스", Capacity: 30, Credit: 3,
-				Day: model.Monday, StartTime: "09:00", EndTime: "10:30",
+				Slots: []dto.MeetingSlotRequest{{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"}},
 			}
 
 			// when
-			_, err := service.Create(req)
+			_, err := service.Create(t.Context(), req)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureIDDuplicate {
+			if !errors.Is(err, exception.ErrLectureIDDuplicate) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureIDDuplicate, err)
 			}
 		})
@@ -75,7 +80,7 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 
 			// when
-			response, _ := service.FindByID(1001)
+			response, _ := service.FindByID(t.Context(), 1001)
 
 			// then
 			if response.ID != 1001 {
@@ -89,10 +94,10 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 
 			// when
-			_, err := service.FindByID(9999)
+			_, err := service.FindByID(t.Context(), 9999)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureNotFound {
+			if !errors.Is(err, exception.ErrLectureNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
 			}
 		})
@@ -105,7 +110,7 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 
 			// when
-			responses, _ := service.List()
+			responses, _ := service.List(t.Context(), "")
 
 			// then
 			if len(responses) != 2 {
@@ -114,6 +119,211 @@ func TestLectureService(t *testing.T) {
 		})
 	})
 
+	t.Run("강좌 목록 페이지 조회", func(t *testing.T) {
+		t.Run("성공 : 페이지 정보 계산", func(t *testing.T) {
+			// given
+			var lectures []model.Lecture
+			for i := 0; i < 5; i++ {
+				lecture, _ := model.NewLecture(1001+i, "강좌"+strconv.Itoa(i), 30, 3, model.Monday, "09:00", "10:30")
+				lectures = append(lectures, *lecture)
+			}
+			mockRepo := &MockLectureRepository{lectures: lectures}
+			service := NewLectureService(mockRepo)
+
+			// when
+			response, _ := service.ListPage(t.Context(), dto.LectureListRequest{Page: 3, Size: 2, Sort: "name", Order: "desc"})
+
+			// then
+			query := mockRepo.lastQuery
+			if query.Offset != 4 || query.Limit != 2 || !query.Descending || response.TotalPages != 3 || len(response.Lectures) != 1 {
+				t.Errorf("기대 : offset 4, limit 2, 내림차순, 3페이지 중 1개, 결과 : %+v, %+v", query, response)
+			}
+		})
+
+		t.Run("기본 페이지 크기", func(t *testing.T) {
+			// given
+			mockRepo := &MockLectureRepository{lectures: []model.Lecture{}}
+			service := NewLectureService(mockRepo)
+
+			// when
+			response, _ := service.ListPage(t.Context(), dto.LectureListRequest{})
+
+			// then
+			if response.Page != 1 || response.Size != constants.LecturePageSizeDefault {
+				t.Errorf("기대 : (1, %d), 결과 : (%d, %d)", constants.LecturePageSizeDefault, response.Page, response.Size)
+			}
+		})
+
+		t.Run("예외 : 잘못된 조회 조건", func(t *testing.T) {
+			service := NewLectureService(&MockLectureRepository{lectures: []model.Lecture{}})
+			testCases := []struct {
+				name     string
+				req      dto.LectureListRequest
+				expected error
+			}{
+				{"존재하지 않는 요일", dto.LectureListRequest{Day: "SUN"}, exception.ErrLectureDayInvalid},
+				{"학점 범위 초과", dto.LectureListRequest{Credit: 7}, exception.ErrLectureCreditInvalid},
+				{"시간 형식 오류", dto.LectureListRequest{StartFrom: "9시"}, exception.ErrLectureTimeFormatInvalid},
+				{"지원하지 않는 정렬 기준", dto.LectureListRequest{Sort: "day"}, exception.ErrLectureSortInvalid},
+				{"잘못된 정렬 방향", dto.LectureListRequest{Order: "up"}, exception.ErrSortOrderInvalid},
+				{"음수 페이지", dto.LectureListRequest{Page: -1}, exception.ErrPageInvalid},
+				{"페이지 크기 초과", dto.LectureListRequest{Size: constants.LecturePageSizeMax + 1}, exception.ErrPageSizeInvalid},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					// when
+					_, err := service.ListPage(t.Context(), tc.req)
+
+					// then
+					if !errors.Is(err, tc.expected) {
+						t.Errorf("기대 : %s, 결과 : %v", tc.expected, err)
+					}
+				})
+			}
+		})
+	})
+
+	t.Run("강좌 검색", func(t *testing.T) {
+		t.Run("초성 검색", func(t *testing.T) {
+			// given
+			lecture, _ := model.NewLecture(1001, "자료구조", 30, 3, model.Monday, "09:00", "10:30")
+			mockRepo := &MockLectureRepository{lectures: []model.Lecture{*lecture}}
+			service := NewLectureService(mockRepo)
+
+			// when
+			responses, _ := service.Search(t.Context(), "ㅈㄹㄱㅈ", "")
+
+			// then
+			if len(responses) != 1 || responses[0].ID != 1001 {
+				t.Errorf("기대 : 1001, 결과 : %v", responses)
+			}
+		})
+
+		t.Run("강좌 등록/삭제 시 색인 갱신", func(t *testing.T) {
+			// given
+			lecture, _ := model.NewLecture(1001, "자료구조", 30, 3, model.Monday, "09:00", "10:30")
+			mockRepo := &MockLectureRepository{lectures: []model.Lecture{*lecture}}
+			service := NewLectureService(mockRepo)
+			_, _ = service.Search(t.Context(), "자료구조", "")
+
+			// when
+			_, _ = service.Create(t.Context(), dto.CreateLectureRequest{
+				ID: 1002, Name: "운영체제", Capacity: 30, Credit: 3,
+				Slots: []dto.MeetingSlotRequest{{Day: model.Tuesday, StartTime: "09:00", EndTime: "10:30"}},
+			})
+			_ = service.Delete(t.Context(), 1001)
+
+			// then
+			created, _ := service.Search(t.Context(), "운영체제", "")
+			deleted, _ := service.Search(t.Context(), "자료구조", "")
+			if len(created) != 1 || len(deleted) != 0 {
+				t.Errorf("기대 : (1, 0), 결과 : (%d, %d)", len(created), len(deleted))
+			}
+		})
+
+		t.Run("예외 : 빈 검색어", func(t *testing.T) {
+			// given
+			service := NewLectureService(&MockLectureRepository{lectures: []model.Lecture{}})
+
+			// when
+			_, err := service.Search(t.Context(), "  ", "")
+
+			// then
+			if !errors.Is(err, exception.ErrSearchQueryRequired) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrSearchQueryRequired, err)
+			}
+		})
+	})
+
+	t.Run("강좌 변경", func(t *testing.T) {
+		newFixture := func() (*MockLectureRepository, *MockEnrollmentRepository) {
+			database, _ := model.NewLecture(1001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+			database.CurrentEnrollment = 2
+			network, _ := model.NewLecture(1002, "네트워크", 30, 3, model.Tuesday, "09:00", "10:30")
+			network.CurrentEnrollment = 1
+			lectures := []model.Lecture{*database, *network}
+			enrollments := []model.Enrollment{
+				{ID: 1, StudentID: 2024, LectureID: 1001, Status: model.EnrollmentStatusEnrolled},
+				{ID: 2, StudentID: 2025, LectureID: 1001, Status: model.EnrollmentStatusEnrolled},
+				{ID: 3, StudentID: 2025, LectureID: 1002, Status: model.EnrollmentStatusEnrolled},
+			}
+			return &MockLectureRepository{lectures: lectures},
+				&MockEnrollmentRepository{enrollments: enrollments, lectures: lectures}
+		}
+		ptr := func(v int) *int { return &v }
+
+		t.Run("성공 : 보낸 항목만 변경하고 색인 갱신", func(t *testing.T) {
+			// given
+			lectureRepo, enrollmentRepo := newFixture()
+			service := NewLectureServiceWithEnrollment(lectureRepo, enrollmentRepo)
+			name := "고급 데이터베이스"
+
+			// when
+			response, err := service.Update(t.Context(), 1001, dto.UpdateLectureRequest{Name: &name, Capacity: ptr(20)})
+
+			// then
+			found, _ := service.Search(t.Context(), "고급", "")
+			if err != nil || response.Name != name || response.Capacity != 20 || response.Slots[0].StartTime != "09:00" || len(found) != 1 {
+				t.Errorf("기대 : (고급 데이터베이스, 20, 09:00, 검색 1건), 결과 : %+v, %v, %d", response, err, len(found))
+			}
+		})
+
+		t.Run("예외 : 정원이 현재 수강 인원보다 작음", func(t *testing.T) {
+			// given
+			lectureRepo, enrollmentRepo := newFixture()
+			service := NewLectureServiceWithEnrollment(lectureRepo, enrollmentRepo)
+
+			// when
+			_, err := service.Update(t.Context(), 1001, dto.UpdateLectureRequest{Capacity: ptr(1)})
+
+			// then
+			if !errors.Is(err, exception.ErrLectureCapacityBelowEnrollment) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureCapacityBelowEnrollment, err)
+			}
+		})
+
+		t.Run("예외 : 수강생의 다른 강좌와 시간 충돌", func(t *testing.T) {
+			// given
+			lectureRepo, enrollmentRepo := newFixture()
+			service := NewLectureServiceWithEnrollment(lectureRepo, enrollmentRepo)
+			// 월요일은 그대로 두고 화요일 수업을 추가
+			slots := []dto.MeetingSlotRequest{
+				{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"},
+				{Day: model.Tuesday, StartTime: "10:00", EndTime: "11:30"},
+			}
+
+			// when
+			_, err := service.Update(t.Context(), 1001, dto.UpdateLectureRequest{Slots: slots})
+
+			// then
+			var domainErr *exception.Error
+			expected := []exception.StudentConflict{{StudentID: 2025, LectureID: 1002, LectureName: "네트워크"}}
+			if !errors.As(err, &domainErr) || !errors.Is(err, exception.ErrLectureUpdateTimeConflict) ||
+				!slices.Equal(domainErr.Conflicts, expected) {
+				t.Errorf("기대 : %v, 결과 : %v", expected, err)
+			}
+			if lecture, _ := lectureRepo.FindByID(t.Context(), 1001); len(lecture.Slots) != 1 {
+				t.Errorf("기대 : 변경되지 않음, 결과 : %v", lecture.Slots)
+			}
+		})
+
+		t.Run("예외 : 다른 강좌와 중복된 강좌명", func(t *testing.T) {
+			// given
+			lectureRepo, enrollmentRepo := newFixture()
+			service := NewLectureServiceWithEnrollment(lectureRepo, enrollmentRepo)
+			name := "네트워크"
+
+			// when
+			_, err := service.Update(t.Context(), 1001, dto.UpdateLectureRequest{Name: &name})
+
+			// then
+			if !errors.Is(err, exception.ErrLectureNameDuplicate) {
+				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNameDuplicate, err)
+			}
+		})
+	})
+
 	t.Run("강좌 삭제", func(t *testing.T) {
 		t.Run("성공", func(t *testing.T) {
 			// given
@@ -122,11 +332,11 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 
 			// when
-			_ = service.Delete(1001)
+			_ = service.Delete(t.Context(), 1001)
 
 			// then
-			_, findErr := service.FindByID(1001)
-			if findErr == nil || findErr.Error() != exception.ErrLectureNotFound {
+			_, findErr := service.FindByID(t.Context(), 1001)
+			if !errors.Is(findErr, exception.ErrLectureNotFound) {
 				t.Error("강의가 삭제되지 않았습니다.")
 			}
 		})
@@ -137,10 +347,10 @@ func TestLectureService(t *testing.T) {
 			service := NewLectureService(mockRepo)
 
 			// when
-			err := service.Delete(9999)
+			err := service.Delete(t.Context(), 9999)
 
 			// then
-			if err == nil || err.Error() != exception.ErrLectureNotFound {
+			if !errors.Is(err, exception.ErrLectureNotFound) {
 				t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureNotFound, err)
 			}
 		})
@@ -154,13 +364,24 @@ type MockLectureRepository struct {
 	createError     error
 	deleteError     error
 	updateError     error
+	lastQuery       repository.LectureQuery
 }
 
-func (m *MockLectureRepository) FindAll() ([]model.Lecture, error) {
+func (m *MockLectureRepository) FindAll(ctx context.Context) ([]model.Lecture, error) {
 	return m.lectures, nil
 }
 
-func (m *MockLectureRepository) FindByID(id int) (model.Lecture, error) {
+func (m *MockLectureRepository) FindPage(ctx context.Context, query repository.LectureQuery) (repository.LecturePage, error) {
+	m.lastQuery = query
+	start := min(query.Offset, len(m.lectures))
+	end := len(m.lectures)
+	if query.Limit > 0 {
+		end = min(start+query.Limit, end)
+	}
+	return repository.LecturePage{Lectures: m.lectures[start:end], Total: len(m.lectures)}, nil
+}
+
+func (m *MockLectureRepository) FindByID(ctx context.Context, id int) (model.Lecture, error) {
 	if m.findByIDError != nil {
 		return model.Lecture{}, m.findByIDError
 	}
@@ -169,22 +390,22 @@ func (m *MockLectureRepository) FindByID(id int) (model.Lecture, error) {
 			return lecture, nil
 		}
 	}
-	return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepository) FindByName(name string) (model.Lecture, error) {
+func (m *MockLectureRepository) FindByName(ctx context.Context, termID, name string) (model.Lecture, error) {
 	if m.findByNameError != nil {
 		return model.Lecture{}, m.findByNameError
 	}
 	for _, lecture := range m.lectures {
-		if lecture.Name == name {
+		if lecture.TermID == termID && lecture.Name == name {
 			return lecture, nil
 		}
 	}
-	return model.Lecture{}, errors.New(exception.ErrLectureNotFound)
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepository) Create(lecture model.Lecture) (model.Lecture, error) {
+func (m *MockLectureRepository) Create(ctx context.Context, lecture model.Lecture) (model.Lecture, error) {
 	if m.createError != nil {
 		return model.Lecture{}, m.createError
 	}
@@ -192,7 +413,7 @@ func (m *MockLectureRepository) Create(lecture model.Lecture) (model.Lecture, er
 	return lecture, nil
 }
 
-func (m *MockLectureRepository) Delete(id int) error {
+func (m *MockLectureRepository) Delete(ctx context.Context, id int) error {
 	if m.deleteError != nil {
 		return m.deleteError
 	}
@@ -202,20 +423,42 @@ func (m *MockLectureRepository) Delete(id int) error {
 			return nil
 		}
 	}
-	return errors.New(exception.ErrLectureNotFound)
+	return exception.ErrLectureNotFound
 }
 
-func (m *MockLectureRepository) UpdateCurrentEnrollment(lectureID int, currentEnrollment int) error {
+func (m *MockLectureRepository) UpdateCurrentEnrollment(ctx context.Context, lectureID, currentEnrollment, expectedVersion int) error {
 	if m.updateError != nil {
 		return m.updateError
 	}
 	for i, lecture := range m.lectures {
 		if lecture.ID == lectureID {
+			if lecture.Version != expectedVersion {
+				return &repository.ConflictError{LectureID: lectureID, ExpectedVersion: expectedVersion}
+			}
 			m.lectures[i].CurrentEnrollment = currentEnrollment
+			m.lectures[i].Version++
 			return nil
 		}
 	}
-	return errors.New(exception.ErrLectureNotFound)
+	return exception.ErrLectureNotFound
+}
+
+func (m *MockLectureRepository) Update(ctx context.Context, lecture model.Lecture, expectedVersion int) (model.Lecture, error) {
+	if m.updateError != nil {
+		return model.Lecture{}, m.updateError
+	}
+	for i, existing := range m.lectures {
+		if existing.ID == lecture.ID {
+			if existing.Version != expectedVersion {
+				return model.Lecture{}, &repository.ConflictError{LectureID: lecture.ID, ExpectedVersion: expectedVersion}
+			}
+			lecture.CurrentEnrollment = existing.CurrentEnrollment
+			lecture.Version = existing.Version + 1
+			m.lectures[i] = lecture
+			return lecture, nil
+		}
+	}
+	return model.Lecture{}, exception.ErrLectureNotFound
 }
 
 type MockEnrollmentRepository struct {
@@ -223,28 +466,38 @@ type MockEnrollmentRepository struct {
 	lectures    []model.Lecture
 }
 
-func (m *MockEnrollmentRepository) Create(enrollment model.Enrollment) (model.Enrollment, error) {
+func (m *MockEnrollmentRepository) Create(ctx context.Context, enrollment model.Enrollment) (model.Enrollment, error) {
 	enrollment.ID = len(m.enrollments) + 1
 	m.enrollments = append(m.enrollments, enrollment)
 	return enrollment, nil
 }
 
-func (m *MockEnrollmentRepository) FindByStudent(studentID int) ([]model.Enrollment, error) {
+func (m *MockEnrollmentRepository) FindByStudent(ctx context.Context, studentID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
 	var result []model.Enrollment
 	for _, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID {
+		if enrollment.StudentID == studentID && enrollment.HasStatus(statuses...) {
 			result = append(result, enrollment)
 		}
 	}
 	return result, nil
 }
 
-func (m *MockEnrollmentRepository) FindLecturesByStudent(studentID int) ([]model.Lecture, error) {
+func (m *MockEnrollmentRepository) FindByLecture(ctx context.Context, lectureID int, statuses ...model.EnrollmentStatus) ([]model.Enrollment, error) {
+	var result []model.Enrollment
+	for _, enrollment := range m.enrollments {
+		if enrollment.LectureID == lectureID && enrollment.HasStatus(statuses...) {
+			result = append(result, enrollment)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockEnrollmentRepository) FindLecturesByStudent(ctx context.Context, studentID int, termID string) ([]model.Lecture, error) {
 	var result []model.Lecture
 	for _, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID {
+		if enrollment.StudentID == studentID && enrollment.IsActive() {
 			for _, lecture := range m.lectures {
-				if lecture.ID == enrollment.LectureID {
+				if lecture.ID == enrollment.LectureID && (termID == "" || lecture.TermID == termID) {
 					result = append(result, lecture)
 				}
 			}
@@ -253,22 +506,32 @@ func (m *MockEnrollmentRepository) FindLecturesByStudent(studentID int) ([]model
 	return result, nil
 }
 
-func (m *MockEnrollmentRepository) CountByLectureID(lectureID int) (int, error) {
+func (m *MockEnrollmentRepository) CountByLectureID(ctx context.Context, lectureID int) (int, error) {
 	count := 0
 	for _, enrollment := range m.enrollments {
-		if enrollment.LectureID == lectureID {
+		if enrollment.LectureID == lectureID && enrollment.IsActive() {
 			count++
 		}
 	}
 	return count, nil
 }
 
-func (m *MockEnrollmentRepository) DeleteByStudentAndLecture(studentID, lectureID int) error {
-	for i, enrollment := range m.enrollments {
-		if enrollment.StudentID == studentID && enrollment.LectureID == lectureID {
-			m.enrollments = append(m.enrollments[:i], m.enrollments[i+1:]...)
+func (m *MockEnrollmentRepository) FindStudentIDsByLecture(ctx context.Context, lectureID int) ([]int, error) {
+	var studentIDs []int
+	for _, enrollment := range m.enrollments {
+		if enrollment.LectureID == lectureID && enrollment.IsActive() {
+			studentIDs = append(studentIDs, enrollment.StudentID)
+		}
+	}
+	return studentIDs, nil
+}
+
+func (m *MockEnrollmentRepository) UpdateStatus(ctx context.Context, enrollment model.Enrollment, previous model.EnrollmentStatus) error {
+	for i, stored := range m.enrollments {
+		if stored.ID == enrollment.ID && stored.Status == previous {
+			m.enrollments[i] = enrollment
 			return nil
 		}
 	}
-	return errors.New("enrollment not found")
+	return exception.ErrEnrollmentNotFound
 }
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/repository"
+	"log"
+	"time"
+)
+
+type MaintenanceService interface {
+	Reconcile(ctx context.Context, repair bool) (dto.ReconcileResponse, error)
+	CacheStats() repository.CacheStats
+}
+
+type maintenanceService struct {
+	uow         repository.UnitOfWork
+	lectureRepo repository.LectureRepository
+	locks       lock.LockManager
+	lockTimeout time.Duration
+	cache       *repository.LectureCache
+	waitlist    WaitlistPromoter
+}
+
+// NewMaintenanceService 수강 인원 보정 시 강좌 잠금을 수강신청과 같은 locks에서 획득
+func NewMaintenanceService(
+	uow repository.UnitOfWork,
+	lectureRepo repository.LectureRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+) MaintenanceService {
+	return &maintenanceService{
+		uow:         uow,
+		lectureRepo: lectureRepo,
+		locks:       locks,
+		lockTimeout: lockTimeout,
+	}
+}
+
+// NewMaintenanceServiceWithCache 점검 전에 강좌 캐시를 비워 저장소의 최신 값과 비교
+func NewMaintenanceServiceWithCache(
+	uow repository.UnitOfWork,
+	lectureRepo repository.LectureRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+	cache *repository.LectureCache,
+) MaintenanceService {
+	return NewMaintenanceServiceWithWaitlist(uow, lectureRepo, locks, lockTimeout, cache, nil)
+}
+
+// NewMaintenanceServiceWithWaitlist waitlist가 있으면 수강 인원을 줄여 보정한 강좌의 빈자리를 대기 순서대로 승격
+func NewMaintenanceServiceWithWaitlist(
+	uow repository.UnitOfWork,
+	lectureRepo repository.LectureRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+	cache *repository.LectureCache,
+	waitlist WaitlistPromoter,
+) MaintenanceService {
+	return &maintenanceService{
+		uow:         uow,
+		lectureRepo: lectureRepo,
+		locks:       locks,
+		lockTimeout: lockTimeout,
+		cache:       cache,
+		waitlist:    waitlist,
+	}
+}
+
+// Reconcile 강좌별 현재 수강 인원과 실제 수강신청 수를 비교하고, repair가 true이면 실제 값으로 보정
+// 보정으로 빈자리가 생기면 강좌 잠금을 놓은 뒤 대기 학생을 승격
+func (s *maintenanceService) Reconcile(ctx context.Context, repair bool) (dto.ReconcileResponse, error) {
+	s.cache.InvalidateAll()
+
+	lectures, err := s.lectureRepo.FindAll(ctx)
+	if err != nil {
+		return dto.ReconcileResponse{}, err
+	}
+
+	response := dto.ReconcileResponse{
+		Checked: len(lectures),
+		Repair:  repair,
+		Drifts:  []dto.LectureDriftResponse{},
+	}
+	for _, lecture := range lectures {
+		drift, err := s.reconcileLecture(ctx, lecture.ID, repair)
+		if err != nil {
+			if errors.Is(err, exception.ErrLectureNotFound) {
+				continue
+			}
+			return dto.ReconcileResponse{}, err
+		}
+		if drift != nil {
+			response.Drifts = append(response.Drifts, *drift)
+			s.promoteWaitlist(ctx, *drift)
+		}
+	}
+
+	return response, nil
+}
+
+// reconcileLecture 강좌 잠금을 쥔 채 수강 인원을 비교하여 수강신청/취소와 겹치지 않도록 함
+func (s *maintenanceService) reconcileLecture(ctx context.Context, lectureID int, repair bool) (*dto.LectureDriftResponse, error) {
+	release, err := s.locks.Acquire(ctx, lock.LectureKey(lectureID), s.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var drift *dto.LectureDriftResponse
+	err = retryOnConflict(func() error {
+		drift = nil
+		return s.uow.Do(ctx, func(repos repository.Repositories) error {
+			lecture, err := repos.Lectures.FindByID(ctx, lectureID)
+			if err != nil {
+				return notFoundError(err, exception.ErrLectureNotFound)
+			}
+
+			actual, err := repos.Enrollments.CountByLectureID(ctx, lectureID)
+			if err != nil {
+				return err
+			}
+			if actual == lecture.CurrentEnrollment {
+				return nil
+			}
+
+			drift = &dto.LectureDriftResponse{
+				LectureID:         lecture.ID,
+				Name:              lecture.Name,
+				CurrentEnrollment: lecture.CurrentEnrollment,
+				ActualEnrollment:  actual,
+			}
+			if !repair {
+				return nil
+			}
+
+			if err := repos.Lectures.UpdateCurrentEnrollment(ctx, lectureID, actual, lecture.Version); err != nil {
+				return err
+			}
+			drift.Repaired = true
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return drift, nil
+}
+
+// promoteWaitlist 보정으로 생긴 빈자리를 대기 순서대로 승격, 보정은 이미 저장되었으므로 실패하면 로그만 남김
+func (s *maintenanceService) promoteWaitlist(ctx context.Context, drift dto.LectureDriftResponse) {
+	if s.waitlist == nil || !drift.Repaired || drift.ActualEnrollment >= drift.CurrentEnrollment {
+		return
+	}
+	if err := s.waitlist.PromoteWaitlist(ctx, drift.LectureID); err != nil {
+		log.Printf("대기 승격 실패 : 강좌 %d (%v)", drift.LectureID, err)
+	}
+}
+
+// CacheStats 강좌 캐시 적중/실패 통계 (캐시를 사용하지 않으면 Enabled false)
+func (s *maintenanceService) CacheStats() repository.CacheStats {
+	return s.cache.Stats()
+}
+
+// StartReconcileScheduler interval마다 Reconcile을 실행하고 불일치를 로그로 남김, 반환된 함수로 중지
+func StartReconcileScheduler(service MaintenanceService, interval time.Duration, repair bool) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				response, err := service.Reconcile(context.Background(), repair)
+				if err != nil {
+					log.Printf("수강 인원 점검 실패 : %v", err)
+					continue
+				}
+				for _, drift := range response.Drifts {
+					log.Printf("수강 인원 불일치 : 강좌 %d (기록 %d, 실제 %d, 보정 %t)",
+						drift.LectureID, drift.CurrentEnrollment, drift.ActualEnrollment, drift.Repaired)
+				}
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
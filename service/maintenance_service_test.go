@@ -0,0 +1,124 @@
+package service
+
+import (
+	"golang-course-registration/common/constants"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"testing"
+	"time"
+)
+
+func TestMaintenanceService(t *testing.T) {
+	t.Run("수강 인원 점검", func(t *testing.T) {
+		t.Run("불일치 보고 (보정 안 함)", func(t *testing.T) {
+			// given
+			service, lectureRepo := newDriftedMaintenanceService(t)
+
+			// when
+			response, _ := service.Reconcile(t.Context(), false)
+
+			// then
+			lecture, _ := lectureRepo.FindByID(t.Context(), 2001)
+			if response.Checked != 2 || len(response.Drifts) != 1 || response.Drifts[0].ActualEnrollment != 1 || lecture.CurrentEnrollment != 5 {
+				t.Errorf("기대 : 2개 점검, 1개 불일치 (기록 5 유지), 결과 : %+v (기록 %d)", response, lecture.CurrentEnrollment)
+			}
+		})
+
+		t.Run("불일치 보정", func(t *testing.T) {
+			// given
+			service, lectureRepo := newDriftedMaintenanceService(t)
+
+			// when
+			response, _ := service.Reconcile(t.Context(), true)
+
+			// then
+			lecture, _ := lectureRepo.FindByID(t.Context(), 2001)
+			if len(response.Drifts) != 1 || !response.Drifts[0].Repaired || lecture.CurrentEnrollment != 1 {
+				t.Errorf("기대 : 1개 보정 (기록 1), 결과 : %+v (기록 %d)", response, lecture.CurrentEnrollment)
+			}
+		})
+
+		t.Run("보정 후 불일치 없음", func(t *testing.T) {
+			// given
+			service, _ := newDriftedMaintenanceService(t)
+			_, _ = service.Reconcile(t.Context(), true)
+
+			// when
+			response, _ := service.Reconcile(t.Context(), false)
+
+			// then
+			if len(response.Drifts) != 0 {
+				t.Errorf("기대 : 0, 결과 : %d", len(response.Drifts))
+			}
+		})
+
+		t.Run("보정으로 생긴 빈자리는 대기 학생 승격", func(t *testing.T) {
+			// given
+			// 정원 1명인 강좌 2001은 기록 1명 / 실제 0명이라 가득 찬 것으로 보여 1002가 대기 중
+			store := repository.NewMemoryStore()
+			lectureRepo := repository.NewMemoryLectureRepository(store)
+			lecture, _ := model.NewLecture(2001, "데이터베이스", 1, 3, model.Monday, "09:00", "10:30")
+			lecture.CurrentEnrollment = 1
+			_, _ = lectureRepo.Create(t.Context(), *lecture)
+			_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1002})
+			locks := lock.NewMemoryLockManager()
+			enrollmentService := NewEnrollmentServiceWithUnitOfWork(
+				repository.NewMemoryUnitOfWork(store), repository.NewMemoryEnrollmentRepository(store), locks, constants.LockTimeoutDefault)
+			_, _ = enrollmentService.JoinWaitlist(t.Context(), 1002, 2001)
+			service := NewMaintenanceServiceWithWaitlist(
+				repository.NewMemoryUnitOfWork(store), lectureRepo, locks, constants.LockTimeoutDefault, nil, enrollmentService)
+
+			// when
+			_, err := service.Reconcile(t.Context(), true)
+
+			// then
+			repaired, _ := lectureRepo.FindByID(t.Context(), 2001)
+			studentIDs, _ := repository.NewMemoryEnrollmentRepository(store).FindStudentIDsByLecture(t.Context(), 2001)
+			if err != nil || repaired.CurrentEnrollment != 1 || len(studentIDs) != 1 || studentIDs[0] != 1002 {
+				t.Errorf("기대 : 1002 승격 (기록 1), 결과 : %v (기록 %d, %v)", studentIDs, repaired.CurrentEnrollment, err)
+			}
+		})
+	})
+
+	t.Run("주기적 점검 실행", func(t *testing.T) {
+		// given
+		service, lectureRepo := newDriftedMaintenanceService(t)
+
+		// when
+		stop := StartReconcileScheduler(service, 10*time.Millisecond, true)
+		defer stop()
+
+		// then
+		deadline := time.Now().Add(time.Second)
+		for time.Now().Before(deadline) {
+			if lecture, _ := lectureRepo.FindByID(t.Context(), 2001); lecture.CurrentEnrollment == 1 {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Error("주기적 점검으로 수강 인원이 보정되지 않았습니다.")
+	})
+}
+
+// newDriftedMaintenanceService 강좌 2001은 기록 5명 / 실제 1명, 강좌 2002는 일치하는 상태
+func newDriftedMaintenanceService(t *testing.T) (MaintenanceService, repository.LectureRepository) {
+	t.Helper()
+	store := repository.NewMemoryStore()
+	lectureRepo := repository.NewMemoryLectureRepository(store)
+	lecture1, _ := model.NewLecture(2001, "데이터베이스", 30, 3, model.Monday, "09:00", "10:30")
+	lecture1.CurrentEnrollment = 5
+	lecture2, _ := model.NewLecture(2002, "운영체제", 30, 3, model.Tuesday, "09:00", "10:30")
+	_, _ = lectureRepo.Create(t.Context(), *lecture1)
+	_, _ = lectureRepo.Create(t.Context(), *lecture2)
+	_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1001})
+	_, _ = repository.NewMemoryEnrollmentRepository(store).Create(t.Context(), model.Enrollment{StudentID: 1001, LectureID: 2001, Status: model.EnrollmentStatusEnrolled})
+
+	service := NewMaintenanceService(
+		repository.NewMemoryUnitOfWork(store),
+		lectureRepo,
+		lock.NewMemoryLockManager(),
+		constants.LockTimeoutDefault,
+	)
+	return service, lectureRepo
+}
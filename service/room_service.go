@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"time"
+)
+
+type RoomService interface {
+	Create(ctx context.Context, req dto.CreateRoomRequest) (dto.RoomResponse, error)
+	List(ctx context.Context) ([]dto.RoomResponse, error)
+	Update(ctx context.Context, id string, req dto.UpdateRoomRequest) (dto.RoomResponse, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type roomService struct {
+	roomRepo    repository.RoomRepository
+	lectureRepo repository.LectureRepository
+	locks       lock.LockManager
+	lockTimeout time.Duration
+}
+
+// NewRoomService lectureRepo는 좌석 수를 줄일 때 배정된 강좌의 정원을 확인하는 데 사용
+func NewRoomService(roomRepo repository.RoomRepository, lectureRepo repository.LectureRepository) RoomService {
+	return NewRoomServiceWithLocks(roomRepo, lectureRepo, lock.NewMemoryLockManager(), constants.LockTimeoutDefault)
+}
+
+// NewRoomServiceWithLocks 좌석 수를 줄일 때 강좌 서비스와 같은 강의실별 잠금을 획득하여
+// 정원 확인과 저장 사이에 강좌 정원 증가나 새 배정이 끼어들지 않게 함
+func NewRoomServiceWithLocks(
+	roomRepo repository.RoomRepository,
+	lectureRepo repository.LectureRepository,
+	locks lock.LockManager,
+	lockTimeout time.Duration,
+) RoomService {
+	return &roomService{roomRepo: roomRepo, lectureRepo: lectureRepo, locks: locks, lockTimeout: lockTimeout}
+}
+
+func (s *roomService) Create(ctx context.Context, req dto.CreateRoomRequest) (dto.RoomResponse, error) {
+	room, err := model.NewRoom(req.ID, req.Name, req.Seats)
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+
+	created, err := s.roomRepo.Create(ctx, *room)
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+	return dto.NewRoomResponse(created), nil
+}
+
+// List 강의실 번호 순 목록
+func (s *roomService) List(ctx context.Context) ([]dto.RoomResponse, error) {
+	rooms, err := s.roomRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.RoomResponse, 0, len(rooms))
+	for _, room := range rooms {
+		responses = append(responses, dto.NewRoomResponse(room))
+	}
+	return responses, nil
+}
+
+// Update 보낸 항목만 변경, 좌석 수는 배정된 모든 학기의 강좌 정원 이상이어야 함 (강의실 잠금을 쥔 채 확인하고 저장)
+func (s *roomService) Update(ctx context.Context, id string, req dto.UpdateRoomRequest) (dto.RoomResponse, error) {
+	if err := req.Validate(); err != nil {
+		return dto.RoomResponse{}, err
+	}
+
+	if err := model.ValidateRoomID(id); err != nil {
+		return dto.RoomResponse{}, err
+	}
+	release, err := s.locks.Acquire(ctx, lock.RoomKey(id), s.lockTimeout)
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+	defer release()
+
+	current, err := s.findRoom(ctx, id)
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+	revised, err := current.Revise(req.Apply(current))
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+
+	if revised.Seats < current.Seats {
+		page, err := s.lectureRepo.FindPage(ctx, repository.LectureQuery{RoomID: id})
+		if err != nil {
+			return dto.RoomResponse{}, err
+		}
+		for _, lecture := range page.Lectures {
+			if lecture.Capacity > revised.Seats {
+				return dto.RoomResponse{}, exception.ErrRoomSeatsBelowCapacity
+			}
+		}
+	}
+
+	updated, err := s.roomRepo.Update(ctx, revised)
+	if err != nil {
+		return dto.RoomResponse{}, err
+	}
+	return dto.NewRoomResponse(updated), nil
+}
+
+// Delete 강좌가 배정된 강의실은 삭제 불가 (배정을 먼저 해제)
+func (s *roomService) Delete(ctx context.Context, id string) error {
+	if _, err := s.findRoom(ctx, id); err != nil {
+		return err
+	}
+	return s.roomRepo.Delete(ctx, id)
+}
+
+// findRoom 형식이 잘못된 강의실 번호는 조회하지 않고 거부
+func (s *roomService) findRoom(ctx context.Context, id string) (model.Room, error) {
+	if err := model.ValidateRoomID(id); err != nil {
+		return model.Room{}, err
+	}
+	room, err := s.roomRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.Room{}, notFoundError(err, exception.ErrRoomNotFound)
+	}
+	return room, nil
+}
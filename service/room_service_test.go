@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRoom(t *testing.T) {
+	const nextTerm = "2027-1"
+
+	// newRoomServices 다음 학기(2027-1)와 강의실 ENG-301(25석)이 등록된 메모리 저장소의 강좌/강의실 서비스
+	newRoomServices := func(t *testing.T) (LectureService, RoomService) {
+		t.Helper()
+		store := repository.NewMemoryStore()
+		termRepo := repository.NewMemoryTermRepository(store)
+		term, _ := model.NewTerm(nextTerm, "2027-03-02", "2027-06-20")
+		_, _ = termRepo.Create(t.Context(), *term)
+
+		lectureRepo := repository.NewMemoryLectureRepository(store)
+		roomRepo := repository.NewMemoryRoomRepository(store)
+		roomService := NewRoomService(roomRepo, lectureRepo)
+		_, _ = roomService.Create(t.Context(), dto.CreateRoomRequest{ID: "ENG-301", Name: "공학관 301호", Seats: 25})
+
+		lectureService := NewLectureServiceWithRooms(
+			lectureRepo, repository.NewMemoryEnrollmentRepository(store),
+			termRepo, roomRepo, model.DefaultSchedulePolicy(), constants.DefaultTermID)
+		return lectureService, roomService
+	}
+
+	newLectureRequest := func(termID string, id int, name string, capacity int, startTime, endTime string) dto.CreateLectureRequest {
+		return dto.CreateLectureRequest{
+			TermID: termID, ID: id, Name: name, Capacity: capacity, Credit: 3, RoomID: "ENG-301",
+			Slots: []dto.MeetingSlotRequest{{Day: model.Monday, StartTime: startTime, EndTime: endTime}},
+		}
+	}
+
+	t.Run("성공 : 같은 강의실이라도 시간이 겹치지 않거나 다른 학기면 배정", func(t *testing.T) {
+		// given
+		lectureService, _ := newRoomServices(t)
+		_, _ = lectureService.Create(t.Context(), newLectureRequest("", 1001, "데이터베이스", 20, "09:00", "10:30"))
+
+		// when
+		later, err := lectureService.Create(t.Context(), newLectureRequest("", 1002, "운영체제", 20, "10:30", "12:00"))
+		next, errNext := lectureService.Create(t.Context(), newLectureRequest(nextTerm, 2001, "데이터베이스", 20, "09:00", "10:30"))
+
+		// then
+		if err != nil || errNext != nil || later.RoomID != "ENG-301" || next.RoomID != "ENG-301" {
+			t.Errorf("기대 : 1002, 2001 모두 ENG-301 배정, 결과 : %+v, %+v (%v, %v)", later, next, err, errNext)
+		}
+	})
+
+	t.Run("예외 : 같은 학기에 같은 강의실의 수업 시간이 겹침", func(t *testing.T) {
+		// given
+		lectureService, _ := newRoomServices(t)
+		_, _ = lectureService.Create(t.Context(), newLectureRequest("", 1001, "데이터베이스", 20, "09:00", "10:30"))
+
+		// when
+		_, err := lectureService.Create(t.Context(), newLectureRequest("", 1002, "운영체제", 20, "10:00", "11:30"))
+
+		// then
+		var domainErr *exception.Error
+		if !errors.Is(err, exception.ErrRoomDoubleBooked) || !errors.As(err, &domainErr) || domainErr.Params["lecture"] != "데이터베이스" {
+			t.Errorf("기대 : %s (데이터베이스), 결과 : %v", exception.ErrRoomDoubleBooked, err)
+		}
+	})
+
+	t.Run("동시 등록 시 같은 강의실의 시간 중복 검사 유지", func(t *testing.T) {
+		// given
+		// 같은 강의실의 강좌 조회를 지연시켜 중복 검사와 저장 사이의 경쟁 구간을 넓힘
+		store := repository.NewMemoryStore()
+		roomRepo := repository.NewMemoryRoomRepository(store)
+		_, _ = NewRoomService(roomRepo, repository.NewMemoryLectureRepository(store)).Create(
+			t.Context(), dto.CreateRoomRequest{ID: "ENG-301", Name: "공학관 301호", Seats: 25})
+		lectureService := NewLectureServiceWithRooms(
+			&slowLectureRepository{repository.NewMemoryLectureRepository(store)}, repository.NewMemoryEnrollmentRepository(store),
+			repository.NewMemoryTermRepository(store), roomRepo, model.DefaultSchedulePolicy(), constants.DefaultTermID)
+
+		// when
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, _ = lectureService.Create(t.Context(), newLectureRequest("", 1001+i, "강좌"+string(rune('A'+i)), 20, "09:00", "10:30"))
+			}(i)
+		}
+		wg.Wait()
+
+		// then
+		page, _ := repository.NewMemoryLectureRepository(store).FindPage(t.Context(), repository.LectureQuery{RoomID: "ENG-301"})
+		if len(page.Lectures) != 1 {
+			t.Errorf("기대 : 1, 결과 : %d", len(page.Lectures))
+		}
+	})
+
+	t.Run("예외 : 정원이 강의실 좌석 수보다 많음", func(t *testing.T) {
+		// given
+		lectureService, _ := newRoomServices(t)
+
+		// when
+		_, err := lectureService.Create(t.Context(), newLectureRequest("", 1001, "데이터베이스", 26, "09:00", "10:30"))
+
+		// then
+		if !errors.Is(err, exception.ErrLectureCapacityExceedsSeats) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureCapacityExceedsSeats, err)
+		}
+	})
+
+	t.Run("예외 : 배정된 강좌 정원보다 적게 좌석 수를 줄임", func(t *testing.T) {
+		// given
+		lectureService, roomService := newRoomServices(t)
+		_, _ = lectureService.Create(t.Context(), newLectureRequest(nextTerm, 2001, "데이터베이스", 20, "09:00", "10:30"))
+		seats := 15
+
+		// when
+		_, err := roomService.Update(t.Context(), "ENG-301", dto.UpdateRoomRequest{Seats: &seats})
+
+		// then
+		if !errors.Is(err, exception.ErrRoomSeatsBelowCapacity) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrRoomSeatsBelowCapacity, err)
+		}
+	})
+
+	t.Run("예외 : 강좌가 배정된 강의실 삭제", func(t *testing.T) {
+		// given
+		lectureService, roomService := newRoomServices(t)
+		_, _ = lectureService.Create(t.Context(), newLectureRequest("", 1001, "데이터베이스", 20, "09:00", "10:30"))
+
+		// when
+		err := roomService.Delete(t.Context(), "ENG-301")
+
+		// then
+		if !errors.Is(err, exception.ErrRoomInUse) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrRoomInUse, err)
+		}
+	})
+}
+
+type slowLectureRepository struct {
+	repository.LectureRepository
+}
+
+// FindPage 읽은 뒤에 지연하여 검사에 쓴 목록이 저장 시점까지 낡은 상태로 남게 함
+func (r *slowLectureRepository) FindPage(ctx context.Context, query repository.LectureQuery) (repository.LecturePage, error) {
+	page, err := r.LectureRepository.FindPage(ctx, query)
+	time.Sleep(time.Millisecond)
+	return page, err
+}
@@ -1,13 +1,14 @@
 package service
 
 import (
+	"context"
 	"golang-course-registration/controller/dto"
 	"golang-course-registration/model"
 	"golang-course-registration/repository"
 )
 
 type StudentService interface {
-	Register(studentId int) (dto.StudentResponse, error)
+	Register(ctx context.Context, studentId int) (dto.StudentResponse, error)
 }
 
 type studentService struct {
@@ -18,13 +19,13 @@ func NewStudentService(repo repository.StudentRepository) StudentService {
 	return &studentService{repo: repo}
 }
 
-func (s *studentService) Register(id int) (dto.StudentResponse, error) {
+func (s *studentService) Register(ctx context.Context, id int) (dto.StudentResponse, error) {
 	student, err := model.NewStudent(id)
 	if err != nil {
 		return dto.StudentResponse{}, err
 	}
 
-	savedStudent, err := s.repo.Create(*student)
+	savedStudent, err := s.repo.Create(ctx, *student)
 	if err != nil {
 		return dto.StudentResponse{}, err
 	}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"golang-course-registration/common/exception"
 	"golang-course-registration/model"
@@ -15,7 +16,7 @@ func TestStudentService(t *testing.T) {
 			service := NewStudentService(mockRepo)
 
 			// when
-			response, _ := service.Register(1001)
+			response, _ := service.Register(t.Context(), 1001)
 
 			// then
 			if response.ID != 1001 {
@@ -38,10 +39,10 @@ func TestStudentService(t *testing.T) {
 			for _, tc := range testCases {
 				t.Run(tc.name, func(t *testing.T) {
 					// when
-					_, err := service.Register(tc.id)
+					_, err := service.Register(t.Context(), tc.id)
 
 					// then
-					if err.Error() != exception.ErrStudentIDInvalid {
+					if !errors.Is(err, exception.ErrStudentIDInvalid) {
 						t.Errorf("기대 : %s, 결과 : %s", exception.ErrStudentIDInvalid, err.Error())
 					}
 				})
@@ -56,7 +57,7 @@ type MockStudentRepository struct {
 	createError   error
 }
 
-func (m *MockStudentRepository) Create(student model.Student) (model.Student, error) {
+func (m *MockStudentRepository) Create(ctx context.Context, student model.Student) (model.Student, error) {
 	if m.createError != nil {
 		return model.Student{}, m.createError
 	}
@@ -69,7 +70,7 @@ func (m *MockStudentRepository) Create(student model.Student) (model.Student, er
 	return student, nil
 }
 
-func (m *MockStudentRepository) FindByID(id int) (model.Student, error) {
+func (m *MockStudentRepository) FindByID(ctx context.Context, id int) (model.Student, error) {
 	if m.findByIDError != nil {
 		return model.Student{}, m.findByIDError
 	}
@@ -78,5 +79,5 @@ func (m *MockStudentRepository) FindByID(id int) (model.Student, error) {
 			return student, nil
 		}
 	}
-	return model.Student{}, errors.New(exception.ErrStudentNotFound)
+	return model.Student{}, exception.ErrStudentNotFound
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+)
+
+type TermService interface {
+	Create(ctx context.Context, req dto.CreateTermRequest) (dto.TermResponse, error)
+	List(ctx context.Context) ([]dto.TermResponse, error)
+}
+
+type termService struct {
+	repo       repository.TermRepository
+	activeTerm string
+}
+
+// NewTermService activeTerm은 수강신청을 받는 현재 학기 (ACTIVE_TERM 설정)
+func NewTermService(repo repository.TermRepository, activeTerm string) TermService {
+	return &termService{repo: repo, activeTerm: activeTerm}
+}
+
+func (s *termService) Create(ctx context.Context, req dto.CreateTermRequest) (dto.TermResponse, error) {
+	term, err := model.NewTerm(req.ID, req.StartDate, req.EndDate)
+	if err != nil {
+		return dto.TermResponse{}, err
+	}
+
+	created, err := s.repo.Create(ctx, *term)
+	if err != nil {
+		return dto.TermResponse{}, err
+	}
+	return dto.NewTermResponse(created, s.activeTerm), nil
+}
+
+// resolveTerm 조회 조건의 학기, 비어 있으면 현재 학기 (현재 학기가 없으면 모든 학기)
+// 학기 형식만 검사하고 존재 여부는 확인하지 않음 (없는 학기는 빈 목록)
+func resolveTerm(termID, activeTerm string) (string, error) {
+	if termID == "" {
+		return activeTerm, nil
+	}
+	if err := model.ValidateTermID(termID); err != nil {
+		return "", err
+	}
+	return termID, nil
+}
+
+// List 시작일 순 학기 목록, 현재 학기는 Active로 표시
+func (s *termService) List(ctx context.Context) ([]dto.TermResponse, error) {
+	terms, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.TermResponse, 0, len(terms))
+	for _, term := range terms {
+		responses = append(responses, dto.NewTermResponse(term, s.activeTerm))
+	}
+	return responses, nil
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"golang-course-registration/common/constants"
+	"golang-course-registration/common/exception"
+	"golang-course-registration/controller/dto"
+	"golang-course-registration/infrastructure/lock"
+	"golang-course-registration/model"
+	"golang-course-registration/repository"
+	"testing"
+)
+
+func TestTermService(t *testing.T) {
+	t.Run("성공 : 시작일 순 목록, 현재 학기 표시", func(t *testing.T) {
+		// given
+		service := NewTermService(repository.NewMemoryTermRepository(repository.NewMemoryStore()), "2027-1")
+		_, _ = service.Create(t.Context(), dto.CreateTermRequest{ID: "2027-1", StartDate: "2027-03-02", EndDate: "2027-06-20"})
+
+		// when
+		terms, err := service.List(t.Context())
+
+		// then
+		if err != nil || len(terms) != 2 || terms[0].ID != constants.DefaultTermID || terms[0].Active || !terms[1].Active {
+			t.Errorf("기대 : [2026-2, 2027-1(현재)], 결과 : %+v (%v)", terms, err)
+		}
+	})
+
+	t.Run("예외 : 이미 있는 학기", func(t *testing.T) {
+		// given
+		service := NewTermService(repository.NewMemoryTermRepository(repository.NewMemoryStore()), constants.DefaultTermID)
+
+		// when
+		_, err := service.Create(t.Context(), dto.CreateTermRequest{ID: constants.DefaultTermID, StartDate: "2026-09-01", EndDate: "2027-02-28"})
+
+		// then
+		if !errors.Is(err, exception.ErrTermDuplicate) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrTermDuplicate, err)
+		}
+	})
+}
+
+func TestTermScope(t *testing.T) {
+	const nextTerm = "2027-1"
+
+	// newTermStore 현재 학기(2026-2)와 다음 학기(2027-1), 학생 1001이 등록된 메모리 저장소
+	newTermStore := func(t *testing.T) *repository.MemoryStore {
+		t.Helper()
+		store := repository.NewMemoryStore()
+		term, _ := model.NewTerm(nextTerm, "2027-03-02", "2027-06-20")
+		_, _ = repository.NewMemoryTermRepository(store).Create(t.Context(), *term)
+		_, _ = repository.NewMemoryStudentRepository(store).Create(t.Context(), model.Student{ID: 1001})
+		return store
+	}
+	newLectureService := func(store *repository.MemoryStore) LectureService {
+		return NewLectureServiceWithTerms(
+			repository.NewMemoryLectureRepository(store), repository.NewMemoryEnrollmentRepository(store),
+			repository.NewMemoryTermRepository(store), model.DefaultSchedulePolicy(), constants.DefaultTermID)
+	}
+	newEnrollmentService := func(store *repository.MemoryStore, activeTerm string) EnrollmentService {
+		return NewEnrollmentServiceWithTerm(
+			repository.NewMemoryUnitOfWork(store), repository.NewMemoryEnrollmentRepository(store),
+			lock.NewMemoryLockManager(), constants.LockTimeoutDefault, activeTerm)
+	}
+
+	newLectureRequest := func(termID string, id int, name string, credit int, day model.Day) dto.CreateLectureRequest {
+		return dto.CreateLectureRequest{
+			TermID: termID, ID: id, Name: name, Capacity: 30, Credit: credit,
+			Slots: []dto.MeetingSlotRequest{{Day: day, StartTime: "09:00", EndTime: "10:30"}},
+		}
+	}
+
+	t.Run("성공 : 학기를 생략하면 현재 학기, 강좌명은 학기별로 고유", func(t *testing.T) {
+		// given
+		lectureService := newLectureService(newTermStore(t))
+
+		// when
+		current, err := lectureService.Create(t.Context(), newLectureRequest("", 1001, "데이터베이스", 3, model.Monday))
+		next, errNext := lectureService.Create(t.Context(), newLectureRequest(nextTerm, 1002, "데이터베이스", 3, model.Monday))
+
+		// then
+		lectures, _ := lectureService.List(t.Context(), nextTerm)
+		if err != nil || errNext != nil || current.TermID != constants.DefaultTermID || next.TermID != nextTerm ||
+			len(lectures) != 1 || lectures[0].ID != 1002 {
+			t.Errorf("기대 : 2026-2, 2027-1에 각각 등록, 결과 : %+v, %+v (%v, %v)", current, next, err, errNext)
+		}
+	})
+
+	t.Run("예외 : 등록되지 않은 학기", func(t *testing.T) {
+		// given
+		lectureService := newLectureService(newTermStore(t))
+
+		// when
+		_, err := lectureService.Create(t.Context(), newLectureRequest("2030-1", 1001, "데이터베이스", 3, model.Monday))
+
+		// then
+		if !errors.Is(err, exception.ErrTermNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrTermNotFound, err)
+		}
+	})
+
+	t.Run("예외 : 현재 학기가 아닌 강좌는 신청 불가", func(t *testing.T) {
+		// given
+		store := newTermStore(t)
+		_, _ = newLectureService(store).Create(t.Context(), newLectureRequest(nextTerm, 1001, "데이터베이스", 3, model.Monday))
+		enrollmentService := newEnrollmentService(store, constants.DefaultTermID)
+
+		// when
+		_, err := enrollmentService.Enroll(t.Context(), 1001, 1001)
+
+		// then
+		if !errors.Is(err, exception.ErrLectureTermNotActive) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrLectureTermNotActive, err)
+		}
+	})
+
+	t.Run("성공 : 다른 학기의 수강 강좌는 학점과 시간 충돌 검사에서 제외", func(t *testing.T) {
+		// given
+		store := newTermStore(t)
+		lectureService := newLectureService(store)
+		current := newEnrollmentService(store, constants.DefaultTermID)
+		for i, day := range []model.Day{model.Monday, model.Tuesday, model.Wednesday} {
+			_, _ = lectureService.Create(t.Context(), newLectureRequest("", 1001+i, fmt.Sprintf("강좌%d", i), 6, day))
+			_, _ = current.Enroll(t.Context(), 1001, 1001+i)
+		}
+		_, _ = lectureService.Create(t.Context(), newLectureRequest(nextTerm, 2001, "운영체제", 3, model.Monday))
+
+		// when
+		enrollment, err := newEnrollmentService(store, nextTerm).Enroll(t.Context(), 1001, 2001)
+
+		// then
+		if err != nil || enrollment.TermID != nextTerm {
+			t.Errorf("기대 : 2027-1 수강신청 성공, 결과 : %+v (%v)", enrollment, err)
+		}
+	})
+}
+
+func TestCloneTerm(t *testing.T) {
+	const nextTerm = "2027-1"
+
+	// newCloneService 현재 학기(2026-2)에 강좌 3개(1002는 폐강 예정)가 있고 다음 학기(2027-1)가 등록된 메모리 저장소
+	newCloneService := func(t *testing.T) LectureService {
+		t.Helper()
+		store := repository.NewMemoryStore()
+		termRepo := repository.NewMemoryTermRepository(store)
+		term, _ := model.NewTerm(nextTerm, "2027-03-02", "2027-06-20")
+		_, _ = termRepo.Create(t.Context(), *term)
+
+		service := NewLectureServiceWithTerms(
+			repository.NewMemoryLectureRepository(store), repository.NewMemoryEnrollmentRepository(store),
+			termRepo, model.DefaultSchedulePolicy(), constants.DefaultTermID)
+		for i, capacity := range []int{20, 25, 30} {
+			_, _ = service.Create(t.Context(), dto.CreateLectureRequest{
+				ID: 1001 + i, Name: fmt.Sprintf("강좌%d", i), Capacity: capacity, Credit: 3,
+				Slots: []dto.MeetingSlotRequest{{Day: model.Monday, StartTime: "09:00", EndTime: "10:30"}},
+			})
+		}
+		discontinued := true
+		_, _ = service.Update(t.Context(), 1002, dto.UpdateLectureRequest{Discontinued: &discontinued})
+		return service
+	}
+
+	t.Run("성공 : dry_run은 저장하지 않고 강좌번호, 정원 변경 계획과 제외/실패 이유를 반환", func(t *testing.T) {
+		// given
+		service := newCloneService(t)
+		req := dto.CloneTermRequest{
+			TargetTerm: nextTerm, SourceTerm: constants.DefaultTermID,
+			IDOffset: 1000, CapacityScale: 1.1, DryRun: true,
+		}
+
+		// when
+		result, err := service.CloneTerm(t.Context(), req)
+
+		// then
+		lectures, _ := service.List(t.Context(), nextTerm)
+		if err != nil || result.Created != 1 || result.Skipped != 1 || result.Failed != 1 || len(lectures) != 0 {
+			t.Fatalf("기대 : 등록 1, 제외 1, 실패 1 (저장 안 함), 결과 : %+v (%v), 저장된 강좌 %d개", result, err, len(lectures))
+		}
+		planned, skipped, failed := result.Lectures[0], result.Lectures[1], result.Lectures[2]
+		if planned.TargetID != 2001 || planned.TargetCapacity != 22 || skipped.Action != dto.CloneActionSkip ||
+			failed.Error == nil || failed.Error.Details[0].Code != exception.ErrLectureCapacityInvalid.Code {
+			t.Errorf("기대 : 1001 → 2001 (정원 22), 1002 제외, 1003 정원 33 초과, 결과 : %+v", result.Lectures)
+		}
+	})
+
+	t.Run("성공 : id_map의 강좌번호가 id_offset보다 우선하고 Create로 등록", func(t *testing.T) {
+		// given
+		service := newCloneService(t)
+		req := dto.CloneTermRequest{
+			TargetTerm: nextTerm, SourceTerm: constants.DefaultTermID,
+			IDOffset: 1000, IDMap: map[int]int{1003: 3003},
+		}
+
+		// when
+		result, err := service.CloneTerm(t.Context(), req)
+
+		// then
+		lectures, _ := service.List(t.Context(), nextTerm)
+		if err != nil || result.Created != 2 || len(lectures) != 2 || lectures[0].ID != 2001 || lectures[1].ID != 3003 ||
+			lectures[1].Capacity != 30 {
+			t.Errorf("기대 : 2001, 3003 (정원 유지) 등록, 결과 : %+v (%v)", lectures, err)
+		}
+	})
+
+	t.Run("예외 : 강좌번호를 바꾸지 않으면 원본 강좌와 번호가 겹침", func(t *testing.T) {
+		// given
+		service := newCloneService(t)
+
+		// when
+		result, err := service.CloneTerm(t.Context(), dto.CloneTermRequest{TargetTerm: nextTerm, SourceTerm: constants.DefaultTermID, DryRun: true})
+
+		// then
+		if err != nil || result.Failed != 2 || result.Lectures[0].Error.Code != exception.ErrLectureIDDuplicate.Code {
+			t.Errorf("기대 : %s 2건, 결과 : %+v (%v)", exception.ErrLectureIDDuplicate, result, err)
+		}
+	})
+
+	t.Run("예외 : 등록되지 않은 대상 학기", func(t *testing.T) {
+		// given
+		service := newCloneService(t)
+
+		// when
+		_, err := service.CloneTerm(t.Context(), dto.CloneTermRequest{TargetTerm: "2030-1", SourceTerm: constants.DefaultTermID})
+
+		// then
+		if !errors.Is(err, exception.ErrTermNotFound) {
+			t.Errorf("기대 : %s, 결과 : %v", exception.ErrTermNotFound, err)
+		}
+	})
+}